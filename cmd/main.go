@@ -1,8 +1,10 @@
-// Package main creates grpc client for sending stream to UploadImage handle server method
+// Package main creates grpc client for sending stream to UploadImage handle server method to attach an image to a car
+// or for saving the cars streamed by ExportCars handle server method to a file
 package main
 
 import (
 	"context"
+	"flag"
 	"io"
 	"os"
 
@@ -10,9 +12,19 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	exportPath   = flag.String("export", "", "save the exported cars to this file instead of uploading the image")
+	exportFormat = flag.String("format", "csv", "format of the exported cars: csv or ndjson")
+	exportBrand  = flag.String("brand", "", "export only the cars of this brand")
+	accessToken  = flag.String("token", "", "access token sent in the Authorization header")
+	imageCarID   = flag.String("car", "", "ID of the car the uploaded image is attached to")
 )
 
 func main() {
+	flag.Parse()
 	conn, err := grpc.Dial("localhost:5433", grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		logrus.Fatalf("could not connect: %v", err)
@@ -23,6 +35,10 @@ func main() {
 			logrus.Fatalf("could not close connection: %v", errConnClose)
 		}
 	}()
+	if *exportPath != "" {
+		exportCars(conn)
+		return
+	}
 	client := proto_services.NewImageServiceClient(conn)
 	file, err := os.Open("../images/smile.png")
 	if err != nil {
@@ -34,12 +50,15 @@ func main() {
 			logrus.Fatalf("could not close file: %v", err)
 		}
 	}()
-	stream, err := client.UploadImage(context.Background())
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", "Bearer "+*accessToken)
+	stream, err := client.UploadImage(ctx)
 	if err != nil {
 		logrus.Fatalf("could not upload file: %v", err)
 	}
 	const bufferSize = 4096
 	buffer := make([]byte, bufferSize)
+	// the car is named by the first message only
+	carID := &proto_services.UUID{Value: *imageCarID}
 	for {
 		bytesRead, errRead := file.Read(buffer)
 		if errRead != nil && errRead != io.EOF {
@@ -49,15 +68,61 @@ func main() {
 		if bytesRead == 0 {
 			break
 		}
-		if errSend := stream.Send(&proto_services.UploadImageRequest{Img: buffer[:bytesRead]}); errSend != nil {
+		if errSend := stream.Send(&proto_services.UploadImageRequest{Img: buffer[:bytesRead], CarID: carID}); errSend != nil {
 			logrus.Errorf("could not send data over stream: %v", errSend)
 			return
 		}
+		carID = nil
 	}
-	_, err = stream.CloseAndRecv()
+	resp, err := stream.CloseAndRecv()
 	if err != nil {
 		logrus.Fatalf("could not close stream and receive response: %v", err)
 	}
 
-	logrus.Println("file uploaded successfully")
+	logrus.Printf("file uploaded successfully as %s", resp.Image.ImgName)
+}
+
+// exportCars writes the chunks streamed by ExportCars to the export file
+func exportCars(conn *grpc.ClientConn) {
+	format := proto_services.CarDataFormat_CAR_DATA_FORMAT_CSV
+	if *exportFormat == "ndjson" {
+		format = proto_services.CarDataFormat_CAR_DATA_FORMAT_NDJSON
+	}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "Authorization", "Bearer "+*accessToken)
+	client := proto_services.NewCarServiceClient(conn)
+	stream, err := client.ExportCars(ctx, &proto_services.ExportCarsRequest{Brand: *exportBrand, Format: format})
+	if err != nil {
+		logrus.Fatalf("could not export cars: %v", err)
+	}
+	file, err := os.Create(*exportPath)
+	if err != nil {
+		logrus.Fatalf("could not create file: %v", err)
+	}
+	complete := false
+	defer func() {
+		errFileClose := file.Close()
+		if errFileClose != nil {
+			logrus.Errorf("could not close file: %v", errFileClose)
+		}
+		// a partial export must not be mistaken for the whole one
+		if !complete {
+			_ = os.Remove(*exportPath)
+		}
+	}()
+	for {
+		resp, errRecv := stream.Recv()
+		if errRecv == io.EOF {
+			break
+		}
+		if errRecv != nil {
+			logrus.Errorf("could not receive exported cars: %v", errRecv)
+			return
+		}
+		if _, errWrite := file.Write(resp.Chunk); errWrite != nil {
+			logrus.Errorf("failed to write file error: %v", errWrite)
+			return
+		}
+	}
+	complete = true
+	logrus.Printf("cars exported to %s successfully", *exportPath)
 }
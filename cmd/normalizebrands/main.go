@@ -0,0 +1,84 @@
+// Package main moves the existing cars to the brands of the catalog once the catalog is filled:
+// the brand of every car is replaced with the name of the brand of the catalog it resolves to and the car gets the brand ID.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/caarlos0/env"
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/repository"
+	"github.com/distuurbia/firstTaskArtyom/internal/service"
+	"github.com/go-redis/redis/v8"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	database      = flag.String("db", "postgres", "database the cars are stored in: postgres or mongo")
+	createMissing = flag.Bool("create", false, "add the brands which are not in the catalog instead of leaving their cars as they are")
+	skipCache     = flag.Bool("nocache", false, "don't drop the moved cars from the Redis cache")
+)
+
+func main() {
+	flag.Parse()
+	var cfg config.Config
+	if err := env.Parse(&cfg); err != nil {
+		logrus.Fatalf("failed to parse config: %v", err)
+	}
+	ctx := context.Background()
+	var cache service.CarCacheInvalidator
+	// the memory cache lives in the server and can't be reached from here, its cars expire by themselves
+	if !*skipCache && cfg.CacheBackend == config.CacheBackendRedis {
+		redisClient := redis.NewClient(&redis.Options{Addr: cfg.RedisAddress, Password: cfg.RedisPassword, DB: 0})
+		defer func() {
+			errClose := redisClient.Close()
+			if errClose != nil {
+				logrus.Errorf("failed to disconnect from Redis: %v", errClose)
+			}
+		}()
+		cache = repository.NewRedisRepository(redisClient, &cfg)
+	}
+	var repo service.BrandRepository
+	switch *database {
+	case "postgres":
+		pool, err := pgxpool.New(ctx, cfg.PostgresPath)
+		if err != nil {
+			logrus.Fatalf("failed to connect to Postgres: %v", err)
+		}
+		defer pool.Close()
+		repo = repository.NewPgRepository(pool)
+	case "mongo":
+		client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoPath))
+		if err != nil {
+			logrus.Fatalf("failed to connect to MongoDB: %v", err)
+		}
+		defer func() {
+			errDisconnect := client.Disconnect(context.Background())
+			if errDisconnect != nil {
+				logrus.Errorf("failed to disconnect from MongoDB: %v", errDisconnect)
+			}
+		}()
+		repoMongo := repository.NewMongoRepository(client)
+		err = repoMongo.EnsureIndexes(ctx)
+		if err != nil {
+			logrus.Fatalf("failed to create MongoDB indexes: %v", err)
+		}
+		repo = repoMongo
+	default:
+		logrus.Fatalf("unknown database %q", *database)
+	}
+	result, err := service.NewBrandEntity(repo, cache).NormalizeCars(ctx, *createMissing)
+	if result != nil {
+		fmt.Printf("updated cars: %d\n", result.Updated)
+		fmt.Printf("created brands: %v\n", result.Created)
+		fmt.Printf("unknown brands: %v\n", result.Unknown)
+	}
+	if err != nil {
+		logrus.Fatalf("failed to normalize brands: %v", err)
+	}
+}
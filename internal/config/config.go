@@ -1,6 +1,23 @@
 // Package config represents struct Config.
 package config
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The cache backends the cars can be cached in.
+const (
+	// CacheBackendRedis caches the cars in Redis shared by every instance, with the recently used cars kept in memory in front of it.
+	CacheBackendRedis = "redis"
+	// CacheBackendMemory caches the cars in the memory of the instance, it is meant for a single instance running without Redis.
+	CacheBackendMemory = "memory"
+	// CacheBackendNoop doesn't cache the cars at all, every read goes to the database.
+	CacheBackendNoop = "noop"
+)
+
 // Config is a structure of environment variables.
 type Config struct {
 	PostgresPath          string `env:"POSTGRES_PATH"`
@@ -10,4 +27,77 @@ type Config struct {
 	Port                  int    `env:"PORT" envDefault:"5433"`
 	RedisAddress          string `env:"REDIS_ADDRESS"`
 	RedisPassword         string `env:"REDIS_PASSWORD"`
+	// DeletedCarRetention is how long deleted cars can be restored before PurgeDeletedCars removes them.
+	DeletedCarRetention time.Duration `env:"DELETED_CAR_RETENTION" envDefault:"720h"`
+	// CacheBackend is where the cars are cached: CacheBackendRedis, CacheBackendMemory or CacheBackendNoop.
+	// Redis isn't connected to unless it is the backend.
+	CacheBackend string `env:"CACHE_BACKEND" envDefault:"redis"`
+	// CacheDegradedMode only logs and counts the failures of the cache, the idempotency keys and the car events instead of failing the calls.
+	CacheDegradedMode bool `env:"CACHE_DEGRADED_MODE" envDefault:"false"`
+	// CarCacheTTL is how long a car stays in the Redis cache after it was read from or written to the database.
+	CarCacheTTL time.Duration `env:"CAR_CACHE_TTL" envDefault:"10m"`
+	// CarCacheTTLJitter is the largest random time added to CarCacheTTL, so the cars cached together don't expire together.
+	CarCacheTTLJitter time.Duration `env:"CAR_CACHE_TTL_JITTER" envDefault:"1m"`
+	// CarNotFoundCacheTTL is how long the cache remembers that there is no car with an ID, so the lookups of unknown IDs don't reach the database.
+	CarNotFoundCacheTTL time.Duration `env:"CAR_NOT_FOUND_CACHE_TTL" envDefault:"30s"`
+	// CarLoadLeaseTTL is how long one instance may load a missed car into the cache while the others wait for it.
+	CarLoadLeaseTTL time.Duration `env:"CAR_LOAD_LEASE_TTL" envDefault:"2s"`
+	// CarLocalCacheSize is the largest number of cars each instance keeps in memory in front of Redis, or instead of it with CacheBackendMemory.
+	CarLocalCacheSize int `env:"CAR_LOCAL_CACHE_SIZE" envDefault:"10000"`
+	// CarLocalCacheTTL is how long a car stays in the memory of an instance, it bounds the staleness when an invalidation is lost.
+	CarLocalCacheTTL time.Duration `env:"CAR_LOCAL_CACHE_TTL" envDefault:"5s"`
+	// CacheKeyPrefix is put in front of the Redis keys of the cache, so instances sharing Redis can keep separate caches.
+	CacheKeyPrefix string `env:"CACHE_KEY_PREFIX" envDefault:"firsttask"`
+	// CacheReconcileInterval is how often the cached cars are compared with the database and the differing ones are dropped, 0 turns it off.
+	CacheReconcileInterval time.Duration `env:"CACHE_RECONCILE_INTERVAL" envDefault:"15m"`
+	// CarStatsCacheTTL is how long GetCarStats serves the statistics cached in Redis before computing them again.
+	CarStatsCacheTTL time.Duration `env:"CAR_STATS_CACHE_TTL" envDefault:"1m"`
+	// ProductionYearMaxAge is how many years before the current one the oldest accepted car may be produced.
+	ProductionYearMaxAge int64 `env:"PRODUCTION_YEAR_MAX_AGE" envDefault:"100"`
+	// ProductionYearMaxLead is how many years after the current one a car may be produced, new models come out early.
+	ProductionYearMaxLead int64 `env:"PRODUCTION_YEAR_MAX_LEAD" envDefault:"1"`
+	// BrandFirstProductionYears is the earliest production year of the brands, such as "Tesla:2008,Audi:1910".
+	BrandFirstProductionYears BrandYears `env:"BRAND_FIRST_PRODUCTION_YEARS"`
+	// MaxCarImageSize is the largest size of an uploaded image of a car in bytes.
+	MaxCarImageSize int64 `env:"MAX_CAR_IMAGE_SIZE" envDefault:"10485760"`
+	// ServiceInterval is how long after the last routine service a car is due for the next one.
+	ServiceInterval time.Duration `env:"SERVICE_INTERVAL" envDefault:"8760h"`
+	// ServiceMileageInterval is how far a car may be driven after the last routine service before it is due for the next one.
+	ServiceMileageInterval int64 `env:"SERVICE_MILEAGE_INTERVAL" envDefault:"15000"`
+	// IdempotencyKeyTTL is how long the response to a call with an Idempotency-Key header is replayed on the retries of the call.
+	IdempotencyKeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	// IdempotencyLeaseTTL is how long a call with an Idempotency-Key header holds the key while it runs,
+	// the key is free again after it if the instance running the call has died. It has to be longer than the calls run.
+	IdempotencyLeaseTTL time.Duration `env:"IDEMPOTENCY_LEASE_TTL" envDefault:"1m"`
+}
+
+// BrandYears maps the lowercased names of brands to years.
+type BrandYears map[string]int64
+
+// UnmarshalText parses the comma separated list of brands and years, a brand is separated from its year by a colon.
+func (b *BrandYears) UnmarshalText(text []byte) error {
+	years := make(BrandYears)
+	for _, pair := range strings.Split(string(text), ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		brand, yearString, ok := strings.Cut(pair, ":")
+		brand = strings.TrimSpace(brand)
+		if !ok || brand == "" {
+			return fmt.Errorf("brand year %q must look like brand:year", pair)
+		}
+		year, err := strconv.ParseInt(strings.TrimSpace(yearString), 10, 64)
+		if err != nil {
+			return fmt.Errorf("year of brand %q is not a number: %w", brand, err)
+		}
+		years[strings.ToLower(brand)] = year
+	}
+	*b = years
+	return nil
+}
+
+// Year returns the year of the brand, the case of the brand doesn't matter.
+func (b BrandYears) Year(brand string) (int64, bool) {
+	year, ok := b[strings.ToLower(brand)]
+	return year, ok
 }
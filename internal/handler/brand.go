@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/proto_services"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateBrand handles the request to add a brand with its aliases to the catalog.
+func (h *GRPCHandler) CreateBrand(ctx context.Context, req *proto_services.CreateBrandRequest) (*proto_services.CreateBrandResponse, error) {
+	brand := &model.Brand{
+		Name:    req.Name,
+		Aliases: req.Aliases,
+	}
+	err := h.validate.StructCtx(ctx, brand)
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return &proto_services.CreateBrandResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = h.brandService.Create(ctx, brand)
+	if err != nil {
+		log.WithField(
+			"Name", brand.Name,
+		).Errorf("failed to create brand: %v", err)
+		return &proto_services.CreateBrandResponse{}, statusError(err)
+	}
+	return &proto_services.CreateBrandResponse{Brand: brandToProto(brand)}, nil
+}
+
+// GetBrand handles the request to retrieve a brand of the catalog by its ID.
+func (h *GRPCHandler) GetBrand(ctx context.Context, req *proto_services.GetBrandRequest) (*proto_services.GetBrandResponse, error) {
+	id, err := uuid.Parse(req.GetID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.GetBrandResponse{}, status.Error(codes.InvalidArgument, "brand ID must be a UUID")
+	}
+	brand, err := h.brandService.Get(ctx, id)
+	if err != nil {
+		log.WithField(
+			"ID", id,
+		).Errorf("failed to get brand: %v", err)
+		return &proto_services.GetBrandResponse{}, statusError(err)
+	}
+	return &proto_services.GetBrandResponse{Brand: brandToProto(brand)}, nil
+}
+
+// ListBrands handles the request to list all brands of the catalog ordered by name.
+func (h *GRPCHandler) ListBrands(ctx context.Context, _ *proto_services.ListBrandsRequest) (*proto_services.ListBrandsResponse, error) {
+	brands, err := h.brandService.List(ctx)
+	if err != nil {
+		log.Errorf("failed to list brands: %v", err)
+		return &proto_services.ListBrandsResponse{}, statusError(err)
+	}
+	protoBrands := make([]*proto_services.Brand, 0, len(brands))
+	for _, brand := range brands {
+		protoBrands = append(protoBrands, brandToProto(brand))
+	}
+	return &proto_services.ListBrandsResponse{Brands: protoBrands}, nil
+}
+
+// UpdateBrand handles the request to replace the name and the aliases of a brand.
+func (h *GRPCHandler) UpdateBrand(ctx context.Context, req *proto_services.UpdateBrandRequest) (*proto_services.UpdateBrandResponse, error) {
+	id, err := uuid.Parse(req.GetID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.UpdateBrandResponse{}, status.Error(codes.InvalidArgument, "brand ID must be a UUID")
+	}
+	brand := &model.Brand{
+		ID:      id,
+		Name:    req.Name,
+		Aliases: req.Aliases,
+	}
+	err = h.validate.StructCtx(ctx, brand)
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return &proto_services.UpdateBrandResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = h.brandService.Update(ctx, brand)
+	if err != nil {
+		log.WithField(
+			"ID", id,
+		).Errorf("failed to update brand: %v", err)
+		return &proto_services.UpdateBrandResponse{}, statusError(err)
+	}
+	return &proto_services.UpdateBrandResponse{Brand: brandToProto(brand)}, nil
+}
+
+// DeleteBrand handles the request to remove a brand which has no cars from the catalog.
+func (h *GRPCHandler) DeleteBrand(ctx context.Context, req *proto_services.DeleteBrandRequest) (*proto_services.DeleteBrandResponse, error) {
+	id, err := uuid.Parse(req.GetID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.DeleteBrandResponse{}, status.Error(codes.InvalidArgument, "brand ID must be a UUID")
+	}
+	err = h.brandService.Delete(ctx, id)
+	if err != nil {
+		log.WithField(
+			"ID", id,
+		).Errorf("failed to delete brand: %v", err)
+		return &proto_services.DeleteBrandResponse{}, statusError(err)
+	}
+	return &proto_services.DeleteBrandResponse{}, nil
+}
+
+// brandToProto converts a brand into its proto message.
+func brandToProto(brand *model.Brand) *proto_services.Brand {
+	return &proto_services.Brand{
+		ID:      &proto_services.UUID{Value: brand.ID.String()},
+		Name:    brand.Name,
+		Aliases: brand.Aliases,
+	}
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/proto_services"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the largest number of bytes sent in one message of an export.
+const exportChunkSize = 4096
+
+// exportCSVHeader names the columns of exported CSV files, the files can be imported back with ImportCars.
+var exportCSVHeader = []string{
+	"id", "brand", "productionyear", "isrunning", "ownerid", "version",
+	"vin", "model", "mileage", "color", "price",
+}
+
+// ExportCars handles the request to stream the cars matching the filter as a CSV or NDJSON file split into chunks.
+func (h *GRPCHandler) ExportCars(req *proto_services.ExportCarsRequest, stream proto_services.CarService_ExportCarsServer) error {
+	ctx := stream.Context()
+	chunks := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+	filter, err := carFilterFromProto(req)
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return err
+	}
+	encode, err := newCarEncoder(req.Format, chunks)
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = h.carService.Stream(ctx, filter, encode)
+	if err == nil {
+		err = chunks.Flush()
+	}
+	if err != nil {
+		log.Errorf("failed to export cars error: %v", err)
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return statusError(err)
+	}
+	return nil
+}
+
+// exportStreamWriter sends everything written to it as the chunks of an export stream.
+type exportStreamWriter struct {
+	stream proto_services.CarService_ExportCarsServer
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+	err := w.stream.Send(&proto_services.ExportCarsResponse{Chunk: chunk})
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// newCarEncoder returns the function writing the cars to w in the given format, the CSV header is written right away.
+func newCarEncoder(format proto_services.CarDataFormat, w io.Writer) (func(car *model.Car) error, error) {
+	switch format {
+	case proto_services.CarDataFormat_CAR_DATA_FORMAT_CSV:
+		writer := csv.NewWriter(w)
+		err := writer.Write(exportCSVHeader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write CSV header: %w", err)
+		}
+		return func(car *model.Car) error {
+			err := writer.Write([]string{
+				car.ID.String(),
+				car.Brand,
+				strconv.FormatInt(car.ProductionYear, 10),
+				strconv.FormatBool(car.IsRunning),
+				car.OwnerID.String(),
+				strconv.FormatInt(car.Version, 10),
+				car.VIN,
+				car.Model,
+				strconv.FormatInt(car.Mileage, 10),
+				car.Color,
+				strconv.FormatInt(car.Price, 10),
+			})
+			if err != nil {
+				return err
+			}
+			// csv.Writer has its own buffer, flushing it hands the row over to the chunks.
+			writer.Flush()
+			return writer.Error()
+		}, nil
+	case proto_services.CarDataFormat_CAR_DATA_FORMAT_NDJSON:
+		encoder := json.NewEncoder(w)
+		return func(car *model.Car) error {
+			return encoder.Encode(car)
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown format %v", format)
+}
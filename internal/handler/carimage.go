@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/proto_services"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UploadImage handles the stream of the chunks of an image and attaches the image to the car named by the first message.
+func (h *GRPCHandler) UploadImage(stream proto_services.ImageService_UploadImageServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "car ID is required")
+	}
+	if err != nil {
+		log.Errorf("failed to receive data chunk error: %v", err)
+		return err
+	}
+	carID, err := uuid.Parse(first.CarID.GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	image, err := h.imageService.Upload(ctx, carID, &imageStreamReader{stream: stream, chunk: first.Img})
+	if err != nil {
+		log.WithField(
+			"CarID", carID,
+		).Errorf("failed to upload image: %v", err)
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return statusError(err)
+	}
+	return stream.SendAndClose(&proto_services.UploadImageResponse{Image: carImageToProto(image)})
+}
+
+// ListCarImages handles the request to list the images of a car, the primary image comes first.
+func (h *GRPCHandler) ListCarImages(ctx context.Context, req *proto_services.ListCarImagesRequest) (*proto_services.ListCarImagesResponse, error) {
+	carID, err := uuid.Parse(req.CarID.GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.ListCarImagesResponse{}, status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	images, err := h.imageService.List(ctx, carID)
+	if err != nil {
+		log.WithField(
+			"CarID", carID,
+		).Errorf("failed to list car images: %v", err)
+		return &proto_services.ListCarImagesResponse{}, statusError(err)
+	}
+	return &proto_services.ListCarImagesResponse{Images: carImagesToProto(images)}, nil
+}
+
+// SetPrimaryCarImage handles the request to make an image the primary one of its car.
+func (h *GRPCHandler) SetPrimaryCarImage(ctx context.Context, req *proto_services.SetPrimaryCarImageRequest) (*proto_services.SetPrimaryCarImageResponse, error) {
+	carID, err := uuid.Parse(req.CarID.GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.SetPrimaryCarImageResponse{}, status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	imageID, err := uuid.Parse(req.ImageID.GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.SetPrimaryCarImageResponse{}, status.Error(codes.InvalidArgument, "image ID must be a UUID")
+	}
+	images, err := h.imageService.SetPrimary(ctx, carID, imageID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"CarID":   carID,
+			"ImageID": imageID,
+		}).Errorf("failed to set primary car image: %v", err)
+		return &proto_services.SetPrimaryCarImageResponse{}, statusError(err)
+	}
+	return &proto_services.SetPrimaryCarImageResponse{Images: carImagesToProto(images)}, nil
+}
+
+// imageStreamReader reads the chunks of an upload stream as one continuous file.
+type imageStreamReader struct {
+	stream proto_services.ImageService_UploadImageServer
+	chunk  []byte
+}
+
+func (r *imageStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = req.Img
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+// carImageToProto converts the metadata of an image, imgName is the name DownloadImage serves the image by.
+func carImageToProto(image *model.CarImage) *proto_services.CarImage {
+	return &proto_services.CarImage{
+		ID:          &proto_services.UUID{Value: image.ID.String()},
+		CarID:       &proto_services.UUID{Value: image.CarID.String()},
+		ContentType: image.ContentType,
+		Size:        image.Size,
+		Checksum:    image.Checksum,
+		Order:       image.Position,
+		ImgName:     path.Join(model.CarImageDir, image.FileName()),
+		CreatedAt:   timestamppb.New(image.CreatedAt),
+	}
+}
+
+// carImagesToProto converts the metadata of the images of a car keeping their order.
+func carImagesToProto(images []*model.CarImage) []*proto_services.CarImage {
+	protoImages := make([]*proto_services.CarImage, 0, len(images))
+	for _, image := range images {
+		protoImages = append(protoImages, carImageToProto(image))
+	}
+	return protoImages
+}
@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/proto_services"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// importBatchSize is the number of imported cars created at once.
+	importBatchSize = 100
+	// maxImportIssues is the largest number of skipped and failed rows described in the summary of an import.
+	maxImportIssues = 1000
+)
+
+// ImportCars handles the stream of CSV or NDJSON chunks with cars, the valid cars are created in batches.
+// The format and the dry run flag are taken from the first message of the stream.
+func (h *GRPCHandler) ImportCars(stream proto_services.CarService_ImportCarsServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return stream.SendAndClose(&proto_services.ImportCarsResponse{})
+	}
+	if err != nil {
+		log.Errorf("failed to receive data chunk error: %v", err)
+		return err
+	}
+	records, err := newCarRecordReader(first.Format, &importStreamReader{stream: stream, chunk: first.Chunk})
+	if err != nil {
+		log.Errorf("failed to read import header: %v", err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	summary := &proto_services.ImportCarsResponse{DryRun: first.DryRun}
+	seen := make(map[uuid.UUID]bool)
+	batch := make([]*model.CarImportRow, 0, importBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := h.carService.Import(ctx, batch, first.DryRun)
+		if err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			if errors.Is(err, model.ErrUnauthenticated) || errors.Is(err, model.ErrPermissionDenied) {
+				return statusError(err)
+			}
+			log.Errorf("failed to import cars: %v", err)
+			for _, row := range batch {
+				if row.Status == model.CarImportPending {
+					row.Status = model.CarImportFailed
+					row.Reason = err.Error()
+				}
+			}
+		}
+		for _, row := range batch {
+			addImportRow(summary, row)
+		}
+		batch = batch[:0]
+		return nil
+	}
+	for {
+		row, err := records.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Errorf("failed to read imported cars: %v", err)
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return err
+		}
+		if row.Status == model.CarImportPending {
+			err = h.validate.StructCtx(ctx, row.Car)
+			if err != nil {
+				row.Status = model.CarImportFailed
+				row.Reason = err.Error()
+			}
+		}
+		if row.Status == model.CarImportPending && seen[row.Car.ID] {
+			row.Status = model.CarImportSkipped
+			row.Reason = "car appears earlier in the import"
+		}
+		if row.Status != model.CarImportPending {
+			addImportRow(summary, row)
+			continue
+		}
+		seen[row.Car.ID] = true
+		batch = append(batch, row)
+		if len(batch) == importBatchSize {
+			err = flush()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = flush()
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(summary)
+}
+
+// addImportRow counts the outcome of an imported row in the summary, skipped and failed rows are also described.
+func addImportRow(summary *proto_services.ImportCarsResponse, row *model.CarImportRow) {
+	var protoStatus proto_services.CarImportStatus
+	switch row.Status {
+	case model.CarImported:
+		summary.Imported++
+		return
+	case model.CarImportSkipped:
+		summary.Skipped++
+		protoStatus = proto_services.CarImportStatus_CAR_IMPORT_SKIPPED
+	default:
+		summary.Failed++
+		protoStatus = proto_services.CarImportStatus_CAR_IMPORT_FAILED
+	}
+	if len(summary.Issues) < maxImportIssues {
+		summary.Issues = append(summary.Issues, &proto_services.ImportCarsRowIssue{
+			Line:   row.Line,
+			Status: protoStatus,
+			Reason: row.Reason,
+		})
+	}
+}
+
+// importStreamReader reads the chunks of an import stream as one continuous file.
+type importStreamReader struct {
+	stream proto_services.CarService_ImportCarsServer
+	chunk  []byte
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = req.Chunk
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+// carRecordReader reads the imported cars one row at a time, it returns io.EOF after the last row.
+// A row which can't be parsed is returned with the failed status, an error means the import can't go on.
+type carRecordReader interface {
+	Next() (*model.CarImportRow, error)
+}
+
+// newCarRecordReader returns the reader of the imported cars in the given format.
+func newCarRecordReader(format proto_services.CarDataFormat, r io.Reader) (carRecordReader, error) {
+	switch format {
+	case proto_services.CarDataFormat_CAR_DATA_FORMAT_CSV:
+		return newCSVCarReader(r)
+	case proto_services.CarDataFormat_CAR_DATA_FORMAT_NDJSON:
+		return &ndjsonCarReader{reader: bufio.NewReader(r)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %v", format)
+}
+
+// csvCarReader reads cars from CSV rows, the first row names the columns.
+type csvCarReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// newCSVCarReader reads the header of the CSV file, the brand and productionyear columns are required.
+func newCSVCarReader(r io.Reader) (*csvCarReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return &csvCarReader{reader: reader}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"brand", "productionyear"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV header has no %s column", required)
+		}
+	}
+	return &csvCarReader{reader: reader, columns: columns}, nil
+}
+
+func (r *csvCarReader) Next() (*model.CarImportRow, error) {
+	if r.columns == nil {
+		return nil, io.EOF
+	}
+	record, err := r.reader.Read()
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &model.CarImportRow{Line: int64(parseErr.StartLine), Status: model.CarImportFailed, Reason: parseErr.Error()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	line, _ := r.reader.FieldPos(0)
+	row := &model.CarImportRow{Line: int64(line), Car: &model.Car{}}
+	column := func(name string) string {
+		i, ok := r.columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	row.Car.Brand = column("brand")
+	row.Car.VIN = normalizeVIN(column("vin"))
+	row.Car.Model = column("model")
+	row.Car.Color = column("color")
+	row.Car.ID, err = importedCarID(column("id"))
+	if err != nil {
+		return failedImportRow(row, err), nil
+	}
+	if value := column("productionyear"); value != "" {
+		row.Car.ProductionYear, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return failedImportRow(row, fmt.Errorf("productionyear is not a number: %q", value)), nil
+		}
+	}
+	if value := column("isrunning"); value != "" {
+		row.Car.IsRunning, err = strconv.ParseBool(value)
+		if err != nil {
+			return failedImportRow(row, fmt.Errorf("isrunning is not a boolean: %q", value)), nil
+		}
+	}
+	if value := column("mileage"); value != "" {
+		row.Car.Mileage, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return failedImportRow(row, fmt.Errorf("mileage is not a number: %q", value)), nil
+		}
+	}
+	if value := column("price"); value != "" {
+		row.Car.Price, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return failedImportRow(row, fmt.Errorf("price is not a number: %q", value)), nil
+		}
+	}
+	return row, nil
+}
+
+// ndjsonCarReader reads cars from lines holding one JSON object each, blank lines are ignored.
+type ndjsonCarReader struct {
+	reader *bufio.Reader
+	line   int64
+}
+
+// ndjsonCar is the JSON object of an imported car.
+type ndjsonCar struct {
+	ID             string            `json:"id"`
+	Brand          string            `json:"brand"`
+	ProductionYear int64             `json:"productionyear"`
+	IsRunning      bool              `json:"isrunning"`
+	VIN            string            `json:"vin"`
+	Model          string            `json:"model"`
+	Mileage        int64             `json:"mileage"`
+	Color          string            `json:"color"`
+	Price          int64             `json:"price"`
+	Labels         map[string]string `json:"labels"`
+}
+
+func (r *ndjsonCarReader) Next() (*model.CarImportRow, error) {
+	for {
+		data, err := r.reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if len(data) == 0 && err == io.EOF {
+			return nil, io.EOF
+		}
+		r.line++
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+		row := &model.CarImportRow{Line: r.line, Car: &model.Car{}}
+		var car ndjsonCar
+		errJSON := json.Unmarshal(data, &car)
+		if errJSON != nil {
+			return failedImportRow(row, fmt.Errorf("line is not a JSON object: %w", errJSON)), nil
+		}
+		row.Car.Brand = car.Brand
+		row.Car.ProductionYear = car.ProductionYear
+		row.Car.IsRunning = car.IsRunning
+		row.Car.VIN = normalizeVIN(car.VIN)
+		row.Car.Model = car.Model
+		row.Car.Mileage = car.Mileage
+		row.Car.Color = car.Color
+		row.Car.Price = car.Price
+		row.Car.Labels = car.Labels
+		row.Car.ID, errJSON = importedCarID(car.ID)
+		if errJSON != nil {
+			return failedImportRow(row, errJSON), nil
+		}
+		return row, nil
+	}
+}
+
+// importedCarID parses the ID of an imported car, a new ID is generated if the row has none.
+func importedCarID(value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.New(), nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("id is not a UUID: %q", value)
+	}
+	return id, nil
+}
+
+// failedImportRow marks the row as failed for the given reason.
+func failedImportRow(row *model.CarImportRow, err error) *model.CarImportRow {
+	row.Status = model.CarImportFailed
+	row.Reason = err.Error()
+	return row
+}
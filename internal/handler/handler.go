@@ -3,14 +3,23 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/distuurbia/firstTaskArtyom/internal/model"
 	"github.com/distuurbia/firstTaskArtyom/proto_services"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"gopkg.in/go-playground/validator.v9"
 )
 
@@ -18,9 +27,20 @@ import (
 type CarService interface {
 	Create(ctx context.Context, car *model.Car) error
 	Get(ctx context.Context, id uuid.UUID) (*model.Car, error)
+	GetByVIN(ctx context.Context, vin string) (*model.Car, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	Update(ctx context.Context, car *model.Car) error
-	GetAll(ctx context.Context) ([]*model.Car, error)
+	Update(ctx context.Context, car *model.Car, fields []string) error
+	GetAll(ctx context.Context, filter *model.CarFilter) ([]*model.Car, string, error)
+	Stream(ctx context.Context, filter *model.CarFilter, send func(car *model.Car) error) error
+	Restore(ctx context.Context, id uuid.UUID) (*model.Car, error)
+	PurgeDeleted(ctx context.Context) (int64, error)
+	GetHistory(ctx context.Context, filter *model.CarHistoryFilter) ([]*model.CarChange, string, error)
+	BatchCreate(ctx context.Context, cars []*model.Car) error
+	BatchUpdate(ctx context.Context, updates []*model.CarUpdate) error
+	BatchDelete(ctx context.Context, ids []uuid.UUID) error
+	Import(ctx context.Context, rows []*model.CarImportRow, dryRun bool) error
+	Watch(ctx context.Context, filter *model.CarWatchFilter, send func(change *model.CarChange) error) error
+	Stats(ctx context.Context) (*model.CarStats, error)
 }
 
 // UserService is an interface that defines the methods on User entity.
@@ -30,22 +50,66 @@ type UserService interface {
 	RefreshToken(ctx context.Context, accessToken string, refreshToken string) (string, string, error)
 }
 
+// CarImageService is an interface that defines the methods on the images of cars.
+type CarImageService interface {
+	Upload(ctx context.Context, carID uuid.UUID, data io.Reader) (*model.CarImage, error)
+	List(ctx context.Context, carID uuid.UUID) ([]*model.CarImage, error)
+	SetPrimary(ctx context.Context, carID, imageID uuid.UUID) ([]*model.CarImage, error)
+}
+
+// MaintenanceService is an interface that defines the methods on the maintenance records of cars.
+type MaintenanceService interface {
+	Add(ctx context.Context, record *model.MaintenanceRecord) error
+	Close(ctx context.Context, id uuid.UUID) (*model.MaintenanceRecord, error)
+	List(ctx context.Context, carID uuid.UUID) ([]*model.MaintenanceRecord, error)
+	DueForService(ctx context.Context) ([]*model.ServiceDue, error)
+}
+
+// ReservationService is an interface that defines the methods on the reservations of cars.
+type ReservationService interface {
+	Create(ctx context.Context, reservation *model.Reservation) error
+	Cancel(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+	ListByCar(ctx context.Context, carID uuid.UUID, includeCanceled bool) ([]*model.Reservation, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, includeCanceled bool) ([]*model.Reservation, error)
+}
+
+// BrandService is an interface that defines the methods on the brand catalog.
+type BrandService interface {
+	Create(ctx context.Context, brand *model.Brand) error
+	Get(ctx context.Context, id uuid.UUID) (*model.Brand, error)
+	List(ctx context.Context) ([]*model.Brand, error)
+	Update(ctx context.Context, brand *model.Brand) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
 // GRPCHandler is responsible for handling gRPC requests related to entities.
 type GRPCHandler struct {
-	carService  CarService
-	userService UserService
-	validate    *validator.Validate
+	carService         CarService
+	userService        UserService
+	imageService       CarImageService
+	maintenanceService MaintenanceService
+	reservationService ReservationService
+	brandService       BrandService
+	validate           *validator.Validate
 	proto_services.UnimplementedCarServiceServer
 	proto_services.UnimplementedUserServiceServer
 	proto_services.UnimplementedImageServiceServer
+	proto_services.UnimplementedMaintenanceServiceServer
+	proto_services.UnimplementedReservationServiceServer
+	proto_services.UnimplementedBrandServiceServer
 }
 
 // NewGRPCHandler creates a new instance of the GRPCHandler struct.
-func NewGRPCHandler(carService CarService, userService UserService, v *validator.Validate) *GRPCHandler {
+func NewGRPCHandler(carService CarService, userService UserService, imageService CarImageService, maintenanceService MaintenanceService,
+	reservationService ReservationService, brandService BrandService, v *validator.Validate) *GRPCHandler {
 	return &GRPCHandler{
-		carService:  carService,
-		userService: userService,
-		validate:    v,
+		carService:         carService,
+		userService:        userService,
+		imageService:       imageService,
+		maintenanceService: maintenanceService,
+		reservationService: reservationService,
+		brandService:       brandService,
+		validate:           v,
 	}
 }
 
@@ -66,25 +130,33 @@ func (h *GRPCHandler) GetCar(ctx context.Context, req *proto_services.GetCarRequ
 		log.WithField(
 			"ID", id,
 		).Errorf("failed to get data: %v", err)
-		return &proto_services.GetCarResponse{}, err
+		return &proto_services.GetCarResponse{}, statusError(err)
 	}
-	protoCar := proto_services.Car{
-		ID:             &proto_services.UUID{Value: car.ID.String()},
-		Brand:          car.Brand,
-		ProductionYear: car.ProductionYear,
-		IsRunning:      car.IsRunning,
+	return &proto_services.GetCarResponse{Car: carToProto(car)}, nil
+}
+
+// GetCarByVIN handles the request to retrieve a car by its VIN.
+func (h *GRPCHandler) GetCarByVIN(ctx context.Context, req *proto_services.GetCarByVINRequest) (*proto_services.GetCarByVINResponse, error) {
+	vin := normalizeVIN(req.VIN)
+	err := h.validate.VarCtx(ctx, vin, "required,vin")
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return &proto_services.GetCarByVINResponse{}, status.Error(codes.InvalidArgument, "VIN is not valid")
+	}
+	car, err := h.carService.GetByVIN(ctx, vin)
+	if err != nil {
+		log.WithField(
+			"VIN", vin,
+		).Errorf("failed to get data: %v", err)
+		return &proto_services.GetCarByVINResponse{}, statusError(err)
 	}
-	return &proto_services.GetCarResponse{Car: &protoCar}, nil
+	return &proto_services.GetCarByVINResponse{Car: carToProto(car)}, nil
 }
 
 // CreateCar handles the POST request to create a new car.
 func (h *GRPCHandler) CreateCar(ctx context.Context, req *proto_services.CreateCarRequest) (*proto_services.CreateCarResponse, error) {
-	newCar := model.Car{
-		ID:             uuid.New(),
-		Brand:          req.Car.Brand,
-		ProductionYear: req.Car.ProductionYear,
-		IsRunning:      req.Car.IsRunning,
-	}
+	newCar := carFromProto(req.Car)
+	newCar.ID = uuid.New()
 	err := h.validate.StructCtx(ctx, newCar)
 	if err != nil {
 		log.Errorf("failed to validate error: %v", err)
@@ -97,36 +169,20 @@ func (h *GRPCHandler) CreateCar(ctx context.Context, req *proto_services.CreateC
 			"PodusctionYear": newCar.ProductionYear,
 			"isRunning":      newCar.IsRunning,
 		}).Errorf("failed to get data: %v", err)
-		return &proto_services.CreateCarResponse{}, err
-	}
-	protoCar := proto_services.Car{
-		ID:             &proto_services.UUID{Value: newCar.ID.String()},
-		Brand:          newCar.Brand,
-		ProductionYear: newCar.ProductionYear,
-		IsRunning:      newCar.IsRunning,
+		return &proto_services.CreateCarResponse{}, statusError(err)
 	}
-	return &proto_services.CreateCarResponse{Car: &protoCar}, nil
+	return &proto_services.CreateCarResponse{Car: carToProto(&newCar)}, nil
 }
 
-// UpdateCar handles the PUT request to update an existing car.
+// UpdateCar handles the PUT request to update an existing car, only the fields named in the update mask are changed.
 func (h *GRPCHandler) UpdateCar(ctx context.Context, req *proto_services.UpdateCarRequest) (*proto_services.UpdateCarResponse, error) {
-	id, err := uuid.Parse(req.Car.ID.Value)
-	if err != nil {
-		log.Errorf("failed to parse error %v", err)
-		return &proto_services.UpdateCarResponse{}, err
-	}
-	car := model.Car{
-		ID:             id,
-		Brand:          req.Car.Brand,
-		ProductionYear: req.Car.ProductionYear,
-		IsRunning:      req.Car.IsRunning,
-	}
-	err = h.validate.StructCtx(ctx, car)
+	update, err := h.carUpdateFromProto(ctx, req)
 	if err != nil {
 		log.Errorf("failed to validate error %v", err)
 		return &proto_services.UpdateCarResponse{}, err
 	}
-	err = h.carService.Update(ctx, &car)
+	car, fields := update.Car, update.Fields
+	err = h.carService.Update(ctx, car, fields)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"ID":             car.ID,
@@ -134,15 +190,40 @@ func (h *GRPCHandler) UpdateCar(ctx context.Context, req *proto_services.UpdateC
 			"PodusctionYear": car.ProductionYear,
 			"isRunning":      car.IsRunning,
 		}).Errorf("failed to get data: %v", err)
-		return &proto_services.UpdateCarResponse{}, err
+		return &proto_services.UpdateCarResponse{}, statusError(err)
 	}
-	protoCar := proto_services.Car{
-		ID:             &proto_services.UUID{Value: car.ID.String()},
-		Brand:          car.Brand,
-		ProductionYear: car.ProductionYear,
-		IsRunning:      car.IsRunning,
+	return &proto_services.UpdateCarResponse{Car: carToProto(car)}, nil
+}
+
+// carUpdateFromProto reads and validates the car and the fields an update request changes,
+// all updatable fields are changed if the update mask is empty. Only the changed fields are checked here,
+// the service checks the whole car after merging them into the stored one.
+func (h *GRPCHandler) carUpdateFromProto(ctx context.Context, req *proto_services.UpdateCarRequest) (*model.CarUpdate, error) {
+	id, err := uuid.Parse(req.GetCar().GetID().GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	car := carFromProto(req.Car)
+	car.ID = id
+	car.Version = req.Car.Version
+	fields := req.GetUpdateMask().GetPaths()
+	if len(fields) == 0 {
+		fields = model.UpdatableCarFields()
+	}
+	for _, field := range fields {
+		if !model.IsUpdatableCarField(field) {
+			return nil, status.Errorf(codes.InvalidArgument, "field %q can't be updated", field)
+		}
+	}
+	err = h.validate.StructPartialCtx(ctx, car, fields...)
+	if err != nil {
+		return nil, err
 	}
-	return &proto_services.UpdateCarResponse{Car: &protoCar}, nil
+	err = h.validate.VarCtx(ctx, car.Version, "gt=0")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "expected version of the car is required")
+	}
+	return &model.CarUpdate{Car: &car, Fields: fields}, nil
 }
 
 // DeleteCar handles the DELETE request to delete a car by its ID.
@@ -162,29 +243,417 @@ func (h *GRPCHandler) DeleteCar(ctx context.Context, req *proto_services.DeleteC
 		log.WithField(
 			"ID", id,
 		).Errorf("failed to get data: %v", err)
-		return &proto_services.DeleteCarResponse{}, err
+		return &proto_services.DeleteCarResponse{}, statusError(err)
 	}
 	return &proto_services.DeleteCarResponse{ID: &proto_services.UUID{Value: id.String()}}, nil
 }
 
-// GetAllCars handles the GET request to retrieve all cars.
-func (h *GRPCHandler) GetAllCars(ctx context.Context, _ *proto_services.GetAllCarsRequest) (*proto_services.GetAllCarsResponse, error) {
-	cars, err := h.carService.GetAll(ctx)
+// BatchCreateCars handles the request to create many cars at once, nothing is created if one of the cars is not valid.
+func (h *GRPCHandler) BatchCreateCars(ctx context.Context, req *proto_services.BatchCreateCarsRequest) (*proto_services.BatchCreateCarsResponse, error) {
+	cars := make([]*model.Car, 0, len(req.Cars))
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, protoCar := range req.Cars {
+		car := carFromProto(protoCar)
+		car.ID = uuid.New()
+		err := h.validate.StructCtx(ctx, car)
+		if err != nil {
+			violations = append(violations, batchViolations(fmt.Sprintf("cars[%d]", i), err)...)
+		}
+		cars = append(cars, &car)
+	}
+	if len(violations) > 0 {
+		log.Errorf("failed to validate error: %d violations in batch", len(violations))
+		return &proto_services.BatchCreateCarsResponse{}, batchValidationError(violations)
+	}
+	err := h.carService.BatchCreate(ctx, cars)
+	if err != nil {
+		log.Errorf("failed to create cars: %v", err)
+		return &proto_services.BatchCreateCarsResponse{}, statusError(err)
+	}
+	protoCars := make([]*proto_services.Car, 0, len(cars))
+	for _, car := range cars {
+		protoCars = append(protoCars, carToProto(car))
+	}
+	return &proto_services.BatchCreateCarsResponse{Cars: protoCars}, nil
+}
+
+// BatchUpdateCars handles the request to update many cars at once, nothing is updated if one of the requests is not valid.
+func (h *GRPCHandler) BatchUpdateCars(ctx context.Context, req *proto_services.BatchUpdateCarsRequest) (*proto_services.BatchUpdateCarsResponse, error) {
+	updates := make([]*model.CarUpdate, 0, len(req.Requests))
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, updateReq := range req.Requests {
+		update, err := h.carUpdateFromProto(ctx, updateReq)
+		if err != nil {
+			violations = append(violations, batchViolations(fmt.Sprintf("requests[%d]", i), err)...)
+			continue
+		}
+		updates = append(updates, update)
+	}
+	if len(violations) > 0 {
+		log.Errorf("failed to validate error: %d violations in batch", len(violations))
+		return &proto_services.BatchUpdateCarsResponse{}, batchValidationError(violations)
+	}
+	err := h.carService.BatchUpdate(ctx, updates)
 	if err != nil {
-		log.Errorf("failed to get all cars error: %v", err)
+		log.Errorf("failed to update cars: %v", err)
+		return &proto_services.BatchUpdateCarsResponse{}, statusError(err)
+	}
+	protoCars := make([]*proto_services.Car, 0, len(updates))
+	for _, update := range updates {
+		protoCars = append(protoCars, carToProto(update.Car))
+	}
+	return &proto_services.BatchUpdateCarsResponse{Cars: protoCars}, nil
+}
+
+// BatchDeleteCars handles the request to delete many cars at once, nothing is deleted if one of the IDs is not valid.
+func (h *GRPCHandler) BatchDeleteCars(ctx context.Context, req *proto_services.BatchDeleteCarsRequest) (*proto_services.BatchDeleteCarsResponse, error) {
+	ids := make([]uuid.UUID, 0, len(req.IDs))
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, protoID := range req.IDs {
+		id, err := uuid.Parse(protoID.GetValue())
+		if err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("IDs[%d]", i),
+				Description: "car ID must be a UUID",
+			})
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(violations) > 0 {
+		log.Errorf("failed to validate error: %d violations in batch", len(violations))
+		return &proto_services.BatchDeleteCarsResponse{}, batchValidationError(violations)
+	}
+	err := h.carService.BatchDelete(ctx, ids)
+	if err != nil {
+		log.Errorf("failed to delete cars: %v", err)
+		return &proto_services.BatchDeleteCarsResponse{}, statusError(err)
+	}
+	return &proto_services.BatchDeleteCarsResponse{IDs: req.IDs}, nil
+}
+
+// RestoreCar handles the request to bring back a deleted car.
+func (h *GRPCHandler) RestoreCar(ctx context.Context, req *proto_services.RestoreCarRequest) (*proto_services.RestoreCarResponse, error) {
+	id, err := uuid.Parse(req.ID.GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.RestoreCarResponse{}, err
+	}
+	car, err := h.carService.Restore(ctx, id)
+	if err != nil {
+		log.WithField(
+			"ID", id,
+		).Errorf("failed to restore car: %v", err)
+		return &proto_services.RestoreCarResponse{}, statusError(err)
+	}
+	return &proto_services.RestoreCarResponse{Car: carToProto(car)}, nil
+}
+
+// PurgeDeletedCars handles the request to permanently remove the cars deleted longer ago than the retention period.
+func (h *GRPCHandler) PurgeDeletedCars(ctx context.Context, _ *proto_services.PurgeDeletedCarsRequest) (*proto_services.PurgeDeletedCarsResponse, error) {
+	purged, err := h.carService.PurgeDeleted(ctx)
+	if err != nil {
+		log.Errorf("failed to purge deleted cars: %v", err)
+		return &proto_services.PurgeDeletedCarsResponse{}, statusError(err)
+	}
+	return &proto_services.PurgeDeletedCarsResponse{Purged: purged}, nil
+}
+
+// GetCarHistory handles the request to get a page of the history of a car.
+func (h *GRPCHandler) GetCarHistory(ctx context.Context, req *proto_services.GetCarHistoryRequest) (*proto_services.GetCarHistoryResponse, error) {
+	carID, err := uuid.Parse(req.CarID.GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.GetCarHistoryResponse{}, status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	err = h.validate.VarCtx(ctx, req.PageSize, "gte=0")
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return &proto_services.GetCarHistoryResponse{}, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+	changes, nextPageToken, err := h.carService.GetHistory(ctx, &model.CarHistoryFilter{
+		CarID:     carID,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		log.WithField(
+			"CarID", carID,
+		).Errorf("failed to get car history: %v", err)
+		return &proto_services.GetCarHistoryResponse{}, statusError(err)
+	}
+	protoChanges := make([]*proto_services.CarChange, 0, len(changes))
+	for _, change := range changes {
+		protoChanges = append(protoChanges, carChangeToProto(change))
+	}
+	return &proto_services.GetCarHistoryResponse{Changes: protoChanges, NextPageToken: nextPageToken}, nil
+}
+
+// GetAllCars handles the GET request to retrieve a page of cars matching the filter.
+func (h *GRPCHandler) GetAllCars(ctx context.Context, req *proto_services.GetAllCarsRequest) (*proto_services.GetAllCarsResponse, error) {
+	err := h.validate.VarCtx(ctx, req.PageSize, "gte=0")
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return &proto_services.GetAllCarsResponse{}, status.Error(codes.InvalidArgument, "page size must not be negative")
+	}
+	filter, err := carFilterFromProto(req)
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
 		return &proto_services.GetAllCarsResponse{}, err
 	}
+	filter.PageSize = int(req.PageSize)
+	filter.PageToken = req.PageToken
+	cars, nextPageToken, err := h.carService.GetAll(ctx, filter)
+	if err != nil {
+		log.Errorf("failed to get all cars error: %v", err)
+		return &proto_services.GetAllCarsResponse{}, statusError(err)
+	}
 	expectedSize := len(cars)
 	var protoCars = make([]*proto_services.Car, 0, expectedSize)
 	for _, car := range cars {
-		protoCars = append(protoCars, &proto_services.Car{
-			ID:             &proto_services.UUID{Value: car.ID.String()},
-			Brand:          car.Brand,
-			ProductionYear: car.ProductionYear,
-			IsRunning:      car.IsRunning,
+		protoCars = append(protoCars, carToProto(car))
+	}
+	return &proto_services.GetAllCarsResponse{Cars: protoCars, NextPageToken: nextPageToken}, nil
+}
+
+// GetCarStats handles the request to count the cars by brand, production year and whether they are running.
+func (h *GRPCHandler) GetCarStats(ctx context.Context, _ *proto_services.GetCarStatsRequest) (*proto_services.GetCarStatsResponse, error) {
+	stats, err := h.carService.Stats(ctx)
+	if err != nil {
+		log.Errorf("failed to get car stats error: %v", err)
+		return &proto_services.GetCarStatsResponse{}, statusError(err)
+	}
+	resp := &proto_services.GetCarStatsResponse{
+		Total:           stats.Total,
+		Running:         stats.Running,
+		NotRunning:      stats.Total - stats.Running,
+		Brands:          make([]*proto_services.BrandCount, 0, len(stats.Brands)),
+		ProductionYears: make([]*proto_services.ProductionYearCount, 0, len(stats.ProductionYears)),
+	}
+	if stats.Total != 0 {
+		resp.RunningShare = stats.RunningShare()
+		resp.NotRunningShare = 1 - resp.RunningShare
+	}
+	for _, brand := range stats.Brands {
+		resp.Brands = append(resp.Brands, &proto_services.BrandCount{Brand: brand.Brand, Count: brand.Count})
+	}
+	for _, year := range stats.ProductionYears {
+		resp.ProductionYears = append(resp.ProductionYears, &proto_services.ProductionYearCount{Year: year.Year, Count: year.Count})
+	}
+	return resp, nil
+}
+
+// ListCars streams all cars matching the filter while they are read from the database.
+func (h *GRPCHandler) ListCars(req *proto_services.ListCarsRequest, stream proto_services.CarService_ListCarsServer) error {
+	ctx := stream.Context()
+	filter, err := carFilterFromProto(req)
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return err
+	}
+	err = h.carService.Stream(ctx, filter, func(car *model.Car) error {
+		return stream.Send(carToProto(car))
+	})
+	if err != nil {
+		log.Errorf("failed to stream cars error: %v", err)
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return statusError(err)
+	}
+	return nil
+}
+
+// WatchCars handles the request to stream the changes of the cars matching the filter as they happen.
+func (h *GRPCHandler) WatchCars(req *proto_services.WatchCarsRequest, stream proto_services.CarService_WatchCarsServer) error {
+	ctx := stream.Context()
+	filter := &model.CarWatchFilter{Brand: req.Brand}
+	if req.CarID.GetValue() != "" {
+		carID, err := uuid.Parse(req.CarID.GetValue())
+		if err != nil {
+			log.Errorf("failed to parse error %v", err)
+			return status.Error(codes.InvalidArgument, "car ID must be a UUID")
+		}
+		filter.CarID = carID
+	}
+	err := h.carService.Watch(ctx, filter, func(change *model.CarChange) error {
+		return stream.Send(carChangeToProto(change))
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		log.Errorf("failed to watch cars error: %v", err)
+		return statusError(err)
+	}
+	return nil
+}
+
+// carFilterRequest is implemented by the requests which select cars by a filter.
+type carFilterRequest interface {
+	GetBrand() string
+	GetMinProductionYear() int64
+	GetMaxProductionYear() int64
+	GetIsRunning() *wrapperspb.BoolValue
+	GetSortBy() proto_services.CarSortField
+	GetDescending() bool
+	GetLabelSelector() string
+}
+
+// carFilterFromProto converts the filter part of the request into model.CarFilter, it fails if the label selector can't be parsed.
+func carFilterFromProto(req carFilterRequest) (*model.CarFilter, error) {
+	labelSelector, err := model.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter := model.CarFilter{
+		LabelSelector:     labelSelector,
+		Brand:             req.GetBrand(),
+		MinProductionYear: req.GetMinProductionYear(),
+		MaxProductionYear: req.GetMaxProductionYear(),
+		Descending:        req.GetDescending(),
+	}
+	if req.GetIsRunning() != nil {
+		isRunning := req.GetIsRunning().Value
+		filter.IsRunning = &isRunning
+	}
+	switch req.GetSortBy() {
+	case proto_services.CarSortField_SORT_BY_BRAND:
+		filter.SortBy = model.SortByBrand
+	case proto_services.CarSortField_SORT_BY_PRODUCTION_YEAR:
+		filter.SortBy = model.SortByProductionYear
+	default:
+		filter.SortBy = model.SortByID
+	}
+	return &filter, nil
+}
+
+// carToProto converts model.Car into the proto Car message.
+func carToProto(car *model.Car) *proto_services.Car {
+	return &proto_services.Car{
+		ID:             &proto_services.UUID{Value: car.ID.String()},
+		Brand:          car.Brand,
+		ProductionYear: car.ProductionYear,
+		IsRunning:      car.IsRunning,
+		OwnerID:        &proto_services.UUID{Value: car.OwnerID.String()},
+		Version:        car.Version,
+		VIN:            car.VIN,
+		Model:          car.Model,
+		Mileage:        car.Mileage,
+		Color:          car.Color,
+		Price:          car.Price,
+		Labels:         car.Labels,
+		BrandID:        &proto_services.UUID{Value: car.BrandID.String()},
+	}
+}
+
+// carFromProto converts the fields of a proto Car a client may set, the VIN is kept in upper case.
+func carFromProto(protoCar *proto_services.Car) model.Car {
+	return model.Car{
+		Brand:          protoCar.GetBrand(),
+		ProductionYear: protoCar.GetProductionYear(),
+		IsRunning:      protoCar.GetIsRunning(),
+		VIN:            normalizeVIN(protoCar.GetVIN()),
+		Model:          protoCar.GetModel(),
+		Mileage:        protoCar.GetMileage(),
+		Color:          protoCar.GetColor(),
+		Price:          protoCar.GetPrice(),
+		Labels:         protoCar.GetLabels(),
+	}
+}
+
+// normalizeVIN trims the VIN and converts it to upper case.
+func normalizeVIN(vin string) string {
+	return strings.ToUpper(strings.TrimSpace(vin))
+}
+
+// carChangeActions maps the actions of the car history to their proto values.
+var carChangeActions = map[model.CarChangeAction]proto_services.CarChangeAction{
+	model.CarCreated:  proto_services.CarChangeAction_CAR_CREATED,
+	model.CarUpdated:  proto_services.CarChangeAction_CAR_UPDATED,
+	model.CarDeleted:  proto_services.CarChangeAction_CAR_DELETED,
+	model.CarRestored: proto_services.CarChangeAction_CAR_RESTORED,
+}
+
+// carChangeToProto converts an entry of the car history into its proto message.
+func carChangeToProto(change *model.CarChange) *proto_services.CarChange {
+	protoChange := &proto_services.CarChange{
+		ID:        &proto_services.UUID{Value: change.ID.String()},
+		CarID:     &proto_services.UUID{Value: change.CarID.String()},
+		ActorID:   &proto_services.UUID{Value: change.ActorID.String()},
+		Action:    carChangeActions[change.Action],
+		ChangedAt: timestamppb.New(change.ChangedAt),
+	}
+	if change.Before != nil {
+		protoChange.Before = carToProto(change.Before)
+	}
+	if change.After != nil {
+		protoChange.After = carToProto(change.After)
+	}
+	return protoChange
+}
+
+// batchViolations describes why the item of a batch at the given path is not valid.
+func batchViolations(path string, err error) []*errdetails.BadRequest_FieldViolation {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       path + "." + fieldErr.Field(),
+				Description: fmt.Sprintf("failed on the %q rule", fieldErr.Tag()),
+			})
+		}
+		return violations
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: status.Convert(err).Message()}}
+}
+
+// batchValidationError returns the InvalidArgument error carrying the violations of all items of a batch.
+func batchValidationError(violations []*errdetails.BadRequest_FieldViolation) error {
+	st, err := status.New(codes.InvalidArgument, "batch items are not valid").WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "batch items are not valid")
+	}
+	return st.Err()
+}
+
+// statusError converts the errors of the services into gRPC status errors with the matching codes.
+func statusError(err error) error {
+	var conflict *model.VersionConflictError
+	if errors.As(err, &conflict) {
+		metadata := map[string]string{"currentVersion": strconv.FormatInt(conflict.CurrentVersion, 10)}
+		var itemErr *model.BatchItemError
+		if errors.As(err, &itemErr) {
+			metadata["batchIndex"] = strconv.Itoa(itemErr.Index)
+		}
+		st, errDetails := status.New(codes.Aborted, conflict.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason:   "VERSION_MISMATCH",
+			Metadata: metadata,
 		})
+		if errDetails != nil {
+			return status.Error(codes.Aborted, conflict.Error())
+		}
+		return st.Err()
+	}
+	switch {
+	case errors.Is(err, model.ErrInvalidPageToken), errors.Is(err, model.ErrBatchTooLarge), errors.Is(err, model.ErrInvalidLabelSelector),
+		errors.Is(err, model.ErrUnsupportedImageType), errors.Is(err, model.ErrCarImageTooLarge), errors.Is(err, model.ErrUnknownBrand),
+		errors.Is(err, model.ErrInvalidCar):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, model.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, model.ErrDuplicateVIN), errors.Is(err, model.ErrDuplicateBrand):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrCarNotFound), errors.Is(err, model.ErrCarImageNotFound), errors.Is(err, model.ErrMaintenanceNotFound),
+		errors.Is(err, model.ErrReservationNotFound), errors.Is(err, model.ErrBrandNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrReservationConflict), errors.Is(err, model.ErrBrandInUse), errors.Is(err, model.ErrCarUnderMaintenance):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	}
-	return &proto_services.GetAllCarsResponse{Cars: protoCars}, nil
+	return err
 }
 
 // InputData is a struct for binding login and password.
@@ -285,14 +754,20 @@ func (h *GRPCHandler) RefreshToken(ctx context.Context, req *proto_services.Refr
 	return &proto_services.RefreshTokenResponse{AccessToken: accessToken, RefreshToken: refreshToken}, nil
 }
 
-// DownloadImage downloads image from given path
+// DownloadImage downloads image from given path, only the name of the file is taken from the path,
+// so an image is never read from outside the images directory and the directory of the images of cars.
 func (h *GRPCHandler) DownloadImage(req *proto_services.DownloadImageRequest, stream proto_services.ImageService_DownloadImageServer) error {
-	imgname := req.ImgName
+	imgname := filepath.Base(req.ImgName)
 	imgpath := filepath.Join("images", imgname)
-	cleanPath := filepath.Clean(imgpath)
-	file, err := os.Open(cleanPath)
+	if filepath.Dir(req.ImgName) == model.CarImageDir {
+		imgpath = filepath.Join("images", model.CarImageDir, imgname)
+	}
+	file, err := os.Open(imgpath)
 	if err != nil {
 		log.Errorf("failed to open file error: %v", err)
+		if errors.Is(err, os.ErrNotExist) {
+			return status.Error(codes.NotFound, "image is not found")
+		}
 		return err
 	}
 	defer func() {
@@ -321,33 +796,3 @@ func (h *GRPCHandler) DownloadImage(req *proto_services.DownloadImageRequest, st
 	}
 	return nil
 }
-
-// UploadImage uploads image from given path
-func (h *GRPCHandler) UploadImage(stream proto_services.ImageService_UploadImageServer) error {
-	dst, err := os.Create(filepath.Join("images", "upload", "uploadedSmile.png"))
-	if err != nil {
-		log.Errorf("failed to create file error: %v", err)
-		return err
-	}
-	defer func() {
-		errClose := dst.Close()
-		if errClose != nil {
-			log.Errorf("failed to close error: %v", errClose)
-		}
-	}()
-	for {
-		fileChunk, err := stream.Recv()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			log.Errorf("failed to receive data chunk error: %v", err)
-			return err
-		}
-		_, err = dst.Write(fileChunk.Img)
-		if err != nil {
-			log.Errorf("failed to write data chunk to the dst file error: %v", err)
-		}
-	}
-	return nil
-}
@@ -2,16 +2,30 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
 	"github.com/distuurbia/firstTaskArtyom/internal/handler/mocks"
 	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/internal/validation"
 	"github.com/distuurbia/firstTaskArtyom/proto_services"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-	"gopkg.in/go-playground/validator.v9"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
@@ -24,7 +38,13 @@ var (
 		ID:             &proto_services.UUID{Value: uuid.New().String()},
 		Brand:          "HandlBrand",
 		ProductionYear: 2002,
-		IsRunning:      false}
+		IsRunning:      false,
+		Version:        1}
+	testConfig = config.Config{
+		ProductionYearMaxAge:      100,
+		ProductionYearMaxLead:     1,
+		BrandFirstProductionYears: config.BrandYears{"tesla": 2008},
+	}
 )
 
 func TestMain(m *testing.M) {
@@ -37,7 +57,7 @@ func TestCreateCar(t *testing.T) {
 	servCar.On("Create", mock.Anything, mock.AnythingOfType("*model.Car")).
 		Return(nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(servCar, nil, validator.New())
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.CreateCar(context.Background(), &proto_services.CreateCarRequest{Car: &testProtoCar})
 	require.NoError(t, err)
 	require.Equal(t, protoResponse.Car.Brand, testProtoCar.Brand)
@@ -46,12 +66,26 @@ func TestCreateCar(t *testing.T) {
 	servCar.AssertExpectations(t)
 }
 
+func TestCreateCarBeforeBrandFirstYear(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(new(mocks.CarService), nil, nil, nil, nil, nil, validation.New(&testConfig))
+	protoCar := &proto_services.Car{Brand: "Tesla", ProductionYear: 2005}
+	_, err := GRPCHandl.CreateCar(context.Background(), &proto_services.CreateCarRequest{Car: protoCar})
+	require.Error(t, err)
+}
+
+func TestCreateCarInvalidLabel(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(new(mocks.CarService), nil, nil, nil, nil, nil, validation.New(&testConfig))
+	protoCar := &proto_services.Car{Brand: "Audi", ProductionYear: 2005, Labels: map[string]string{"region": "-minsk"}}
+	_, err := GRPCHandl.CreateCar(context.Background(), &proto_services.CreateCarRequest{Car: protoCar})
+	require.Error(t, err)
+}
+
 func TestGetCar(t *testing.T) {
 	servCar := new(mocks.CarService)
 	servCar.On("Get", mock.Anything, mock.AnythingOfType("uuid.UUID")).
 		Return(&testModel, nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(servCar, nil, validator.New())
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.GetCar(context.Background(), &proto_services.GetCarRequest{ID: testProtoCar.ID})
 	require.NoError(t, err)
 	require.Equal(t, protoResponse.Car.Brand, testProtoCar.Brand)
@@ -60,24 +94,165 @@ func TestGetCar(t *testing.T) {
 	servCar.AssertExpectations(t)
 }
 
+func TestGetCarNotFound(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Get", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+		Return(nil, fmt.Errorf("CarEntity-Get: %w", model.ErrCarNotFound)).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.GetCar(context.Background(), &proto_services.GetCarRequest{ID: testProtoCar.ID})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	servCar.AssertExpectations(t)
+}
+
+func TestGetCarPermissionDenied(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Get", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+		Return(nil, fmt.Errorf("CarEntity-Get: %w", model.ErrPermissionDenied)).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.GetCar(context.Background(), &proto_services.GetCarRequest{ID: testProtoCar.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	servCar.AssertExpectations(t)
+}
+
+func TestGetCarByVIN(t *testing.T) {
+	car := testModel
+	car.VIN = "1M8GDM9AXKP042788"
+	servCar := new(mocks.CarService)
+	servCar.On("GetByVIN", mock.Anything, car.VIN).
+		Return(&car, nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.GetCarByVIN(context.Background(), &proto_services.GetCarByVINRequest{VIN: " 1m8gdm9axkp042788"})
+	require.NoError(t, err)
+	require.Equal(t, car.VIN, resp.Car.VIN)
+	servCar.AssertExpectations(t)
+}
+
+func TestGetCarByVINNotFound(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("GetByVIN", mock.Anything, "1M8GDM9AXKP042788").
+		Return(nil, fmt.Errorf("CarEntity-GetByVIN: %w", model.ErrCarNotFound)).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.GetCarByVIN(context.Background(), &proto_services.GetCarByVINRequest{VIN: "1M8GDM9AXKP042788"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	servCar.AssertExpectations(t)
+}
+
+func TestGetCarByVINBadCheckDigit(t *testing.T) {
+	servCar := new(mocks.CarService)
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.GetCarByVIN(context.Background(), &proto_services.GetCarByVINRequest{VIN: "1M8GDM9A1KP042788"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	servCar.AssertNotCalled(t, "GetByVIN", mock.Anything, mock.Anything)
+}
+
+func TestCreateCarUnknownBrand(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Create", mock.Anything, mock.AnythingOfType("*model.Car")).
+		Return(fmt.Errorf("CarEntity-Create: %w", model.ErrUnknownBrand)).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.CreateCar(context.Background(), &proto_services.CreateCarRequest{Car: &testProtoCar})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	servCar.AssertExpectations(t)
+}
+
+func TestCreateCarDuplicateVIN(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Create", mock.Anything, mock.MatchedBy(func(car *model.Car) bool {
+		return car.VIN == "1M8GDM9AXKP042788" && car.Model == "Q7" && car.Price == 1500000
+	})).
+		Return(fmt.Errorf("CarEntity-Create: %w", model.ErrDuplicateVIN)).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	protoCar := &proto_services.Car{Brand: "Audi", ProductionYear: 2015, VIN: "1M8GDM9AXKP042788", Model: "Q7", Mileage: 120000, Price: 1500000}
+	_, err := GRPCHandl.CreateCar(context.Background(), &proto_services.CreateCarRequest{Car: protoCar})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	servCar.AssertExpectations(t)
+}
+
 func TestDeleteCar(t *testing.T) {
 	servCar := new(mocks.CarService)
 	servCar.On("Delete", mock.Anything, mock.AnythingOfType("uuid.UUID")).
 		Return(nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(servCar, nil, validator.New())
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.DeleteCar(context.Background(), &proto_services.DeleteCarRequest{ID: testProtoCar.ID})
 	require.NoError(t, err)
 	require.Equal(t, protoResponse.ID, testProtoCar.ID)
 	servCar.AssertExpectations(t)
 }
 
+func TestRestoreCar(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Restore", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+		Return(&testModel, nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.RestoreCar(context.Background(), &proto_services.RestoreCarRequest{ID: testProtoCar.ID})
+	require.NoError(t, err)
+	require.Equal(t, testModel.Brand, resp.Car.Brand)
+	servCar.AssertExpectations(t)
+}
+
+func TestPurgeDeletedCars(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("PurgeDeleted", mock.Anything).
+		Return(int64(3), nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.PurgeDeletedCars(context.Background(), &proto_services.PurgeDeletedCarsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resp.Purged)
+	servCar.AssertExpectations(t)
+}
+
+func TestPurgeDeletedCarsPermissionDenied(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("PurgeDeleted", mock.Anything).
+		Return(int64(0), fmt.Errorf("CarEntity-PurgeDeleted: %w", model.ErrPermissionDenied)).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.PurgeDeletedCars(context.Background(), &proto_services.PurgeDeletedCarsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	servCar.AssertExpectations(t)
+}
+
+func TestGetCarHistory(t *testing.T) {
+	before, after := testModel, testModel
+	after.Brand = "UpdatedBrand"
+	servCar := new(mocks.CarService)
+	servCar.On("GetHistory", mock.Anything, mock.AnythingOfType("*model.CarHistoryFilter")).
+		Return([]*model.CarChange{{
+			ID:        uuid.New(),
+			CarID:     testModel.ID,
+			ActorID:   testModel.OwnerID,
+			Action:    model.CarUpdated,
+			ChangedAt: time.Now(),
+			Before:    &before,
+			After:     &after,
+		}}, "next", nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.GetCarHistory(context.Background(), &proto_services.GetCarHistoryRequest{CarID: testProtoCar.ID, PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Changes, 1)
+	require.Equal(t, proto_services.CarChangeAction_CAR_UPDATED, resp.Changes[0].Action)
+	require.Equal(t, testModel.Brand, resp.Changes[0].Before.Brand)
+	require.Equal(t, "UpdatedBrand", resp.Changes[0].After.Brand)
+	require.Equal(t, "next", resp.NextPageToken)
+	servCar.AssertExpectations(t)
+}
+
 func TestUpdatecar(t *testing.T) {
 	servCar := new(mocks.CarService)
-	servCar.On("Update", mock.Anything, mock.AnythingOfType("*model.Car")).
+	servCar.On("Update", mock.Anything, mock.AnythingOfType("*model.Car"), model.UpdatableCarFields()).
 		Return(nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(servCar, nil, validator.New())
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.UpdateCar(context.Background(), &proto_services.UpdateCarRequest{Car: &testProtoCar})
 	require.NoError(t, err)
 	require.Equal(t, protoResponse.Car.ID, testProtoCar.ID)
@@ -87,6 +262,117 @@ func TestUpdatecar(t *testing.T) {
 	servCar.AssertExpectations(t)
 }
 
+func TestUpdateCarFieldMask(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Update", mock.Anything, mock.AnythingOfType("*model.Car"), []string{model.CarFieldIsRunning}).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.UpdateCar(context.Background(), &proto_services.UpdateCarRequest{
+		Car:        &proto_services.Car{ID: testProtoCar.ID, IsRunning: true, Version: 1},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{model.CarFieldIsRunning}},
+	})
+	require.NoError(t, err)
+	servCar.AssertExpectations(t)
+}
+
+func TestUpdateCarUnknownField(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(new(mocks.CarService), nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.UpdateCar(context.Background(), &proto_services.UpdateCarRequest{
+		Car:        &testProtoCar,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"OwnerID"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateCarVersionConflict(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Update", mock.Anything, mock.AnythingOfType("*model.Car"), mock.Anything).
+		Return(fmt.Errorf("CarEntity-Update: %w", &model.VersionConflictError{CurrentVersion: 3})).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.UpdateCar(context.Background(), &proto_services.UpdateCarRequest{Car: &testProtoCar})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	require.Equal(t, "3", details[0].(*errdetails.ErrorInfo).Metadata["currentVersion"])
+	servCar.AssertExpectations(t)
+}
+
+func TestUpdateCarWithoutVersion(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(new(mocks.CarService), nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.UpdateCar(context.Background(), &proto_services.UpdateCarRequest{Car: &proto_services.Car{
+		ID:             testProtoCar.ID,
+		Brand:          testProtoCar.Brand,
+		ProductionYear: testProtoCar.ProductionYear,
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBatchCreateCars(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("BatchCreate", mock.Anything, mock.AnythingOfType("[]*model.Car")).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.BatchCreateCars(context.Background(), &proto_services.BatchCreateCarsRequest{
+		Cars: []*proto_services.Car{&testProtoCar, &testProtoCar},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Cars, 2)
+	require.NotEqual(t, resp.Cars[0].ID.Value, resp.Cars[1].ID.Value)
+	servCar.AssertExpectations(t)
+}
+
+func TestBatchCreateCarsValidation(t *testing.T) {
+	servCar := new(mocks.CarService)
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.BatchCreateCars(context.Background(), &proto_services.BatchCreateCarsRequest{
+		Cars: []*proto_services.Car{&testProtoCar, {Brand: "", ProductionYear: 1900}},
+	})
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	require.Equal(t, "cars[1].Brand", badRequest.FieldViolations[0].Field)
+	require.Equal(t, "cars[1].ProductionYear", badRequest.FieldViolations[1].Field)
+	servCar.AssertNotCalled(t, "BatchCreate", mock.Anything, mock.Anything)
+}
+
+func TestBatchUpdateCarsVersionConflict(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("BatchUpdate", mock.Anything, mock.AnythingOfType("[]*model.CarUpdate")).
+		Return(fmt.Errorf("CarEntity-BatchUpdate: %w", &model.BatchItemError{Index: 1, Err: &model.VersionConflictError{CurrentVersion: 4}})).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.BatchUpdateCars(context.Background(), &proto_services.BatchUpdateCarsRequest{
+		Requests: []*proto_services.UpdateCarRequest{{Car: &testProtoCar}, {Car: &testProtoCar}},
+	})
+	st := status.Convert(err)
+	require.Equal(t, codes.Aborted, st.Code())
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, "1", info.Metadata["batchIndex"])
+	require.Equal(t, "4", info.Metadata["currentVersion"])
+	servCar.AssertExpectations(t)
+}
+
+func TestBatchDeleteCarsBadID(t *testing.T) {
+	servCar := new(mocks.CarService)
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.BatchDeleteCars(context.Background(), &proto_services.BatchDeleteCarsRequest{
+		IDs: []*proto_services.UUID{testProtoCar.ID, {Value: "not a UUID"}},
+	})
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Equal(t, "IDs[1]", badRequest.FieldViolations[0].Field)
+	servCar.AssertNotCalled(t, "BatchDelete", mock.Anything, mock.Anything)
+}
+
 func TestGetAllCar(t *testing.T) {
 	servCar := new(mocks.CarService)
 	expectedCars := []*model.Car{
@@ -103,13 +389,272 @@ func TestGetAllCar(t *testing.T) {
 			IsRunning:      false,
 		},
 	}
-	servCar.On("GetAll", mock.Anything).
-		Return(expectedCars, nil).
+	servCar.On("GetAll", mock.Anything, mock.AnythingOfType("*model.CarFilter")).
+		Return(expectedCars, "nextPage", nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(servCar, nil, validator.New())
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.GetAllCars(context.Background(), &proto_services.GetAllCarsRequest{})
 	require.NoError(t, err)
 	require.Equal(t, len(expectedCars), len(protoResponse.Cars))
+	require.Equal(t, "nextPage", protoResponse.NextPageToken)
+	servCar.AssertExpectations(t)
+}
+
+func TestGetAllCarFilter(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("GetAll", mock.Anything, mock.MatchedBy(func(filter *model.CarFilter) bool {
+		return filter.Brand == "handlBrand" &&
+			filter.MinProductionYear == 1990 &&
+			filter.IsRunning != nil && *filter.IsRunning &&
+			filter.SortBy == model.SortByProductionYear &&
+			filter.Descending &&
+			filter.PageSize == 10 &&
+			filter.PageToken == "token"
+	})).
+		Return([]*model.Car{}, "", nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	protoResponse, err := GRPCHandl.GetAllCars(context.Background(), &proto_services.GetAllCarsRequest{
+		Brand:             "handlBrand",
+		MinProductionYear: 1990,
+		IsRunning:         wrapperspb.Bool(true),
+		SortBy:            proto_services.CarSortField_SORT_BY_PRODUCTION_YEAR,
+		Descending:        true,
+		PageSize:          10,
+		PageToken:         "token",
+	})
+	require.NoError(t, err)
+	require.Empty(t, protoResponse.NextPageToken)
+	servCar.AssertExpectations(t)
+}
+
+func TestGetAllCarsLabelSelector(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("GetAll", mock.Anything, mock.MatchedBy(func(filter *model.CarFilter) bool {
+		return assert.ObjectsAreEqual(model.LabelSelector{
+			{Key: "region", Operator: model.LabelEquals, Values: []string{"minsk"}},
+			{Key: "pool", Operator: model.LabelNotEquals, Values: []string{"reserve"}},
+			{Key: "team/tier", Operator: model.LabelIn, Values: []string{"gold", "silver"}},
+			{Key: "retired", Operator: model.LabelDoesNotExist},
+		}, filter.LabelSelector)
+	})).
+		Return([]*model.Car{}, "", nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.GetAllCars(context.Background(), &proto_services.GetAllCarsRequest{
+		LabelSelector: "region=minsk, pool!=reserve, team/tier in (gold, silver), !retired",
+	})
+	require.NoError(t, err)
+	servCar.AssertExpectations(t)
+}
+
+func TestGetAllCarsInvalidLabelSelector(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(new(mocks.CarService), nil, nil, nil, nil, nil, validation.New(&testConfig))
+	for _, selector := range []string{"region=minsk,", "region in minsk", "app.kubernetes.io/name=car", "region=min sk"} {
+		_, err := GRPCHandl.GetAllCars(context.Background(), &proto_services.GetAllCarsRequest{LabelSelector: selector})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), selector)
+	}
+}
+
+// listCarsStream is a fake server stream of the ListCars call which collects the sent cars.
+type listCarsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	cars []*proto_services.Car
+}
+
+func (s *listCarsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *listCarsStream) Send(car *proto_services.Car) error {
+	s.cars = append(s.cars, car)
+	return nil
+}
+
+func TestListCars(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Stream", mock.Anything, mock.MatchedBy(func(filter *model.CarFilter) bool {
+		return filter.Brand == testModel.Brand
+	}), mock.Anything).
+		Run(func(args mock.Arguments) {
+			send := args.Get(2).(func(*model.Car) error)
+			require.NoError(t, send(&testModel))
+			require.NoError(t, send(&testModel))
+		}).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	stream := &listCarsStream{ctx: context.Background()}
+	err := GRPCHandl.ListCars(&proto_services.ListCarsRequest{Brand: testModel.Brand}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.cars, 2)
+	require.Equal(t, testModel.ID.String(), stream.cars[0].ID.Value)
+	servCar.AssertExpectations(t)
+}
+
+func TestListCarsCanceled(t *testing.T) {
+	servCar := new(mocks.CarService)
+	ctx, cancel := context.WithCancel(context.Background())
+	servCar.On("Stream", mock.Anything, mock.AnythingOfType("*model.CarFilter"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			cancel()
+		}).
+		Return(context.Canceled).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	err := GRPCHandl.ListCars(&proto_services.ListCarsRequest{}, &listCarsStream{ctx: ctx})
+	require.Equal(t, codes.Canceled, status.Code(err))
+	servCar.AssertExpectations(t)
+}
+
+// importCarsStream is a fake server stream of the ImportCars call which receives the given chunks.
+type importCarsStream struct {
+	grpc.ServerStream
+	requests []*proto_services.ImportCarsRequest
+	summary  *proto_services.ImportCarsResponse
+}
+
+func (s *importCarsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *importCarsStream) Recv() (*proto_services.ImportCarsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importCarsStream) SendAndClose(summary *proto_services.ImportCarsResponse) error {
+	s.summary = summary
+	return nil
+}
+
+// markImported makes the mocked Import mark all rows as imported.
+func markImported(args mock.Arguments) {
+	for _, row := range args.Get(1).([]*model.CarImportRow) {
+		row.Status = model.CarImported
+	}
+}
+
+func TestImportCarsCSV(t *testing.T) {
+	id := uuid.New().String()
+	servCar := new(mocks.CarService)
+	servCar.On("Import", mock.Anything, mock.MatchedBy(func(rows []*model.CarImportRow) bool {
+		return len(rows) == 2 && rows[0].Line == 2 && rows[0].Car.ID.String() == id && rows[1].Line == 5
+	}), false).
+		Run(markImported).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	stream := &importCarsStream{requests: []*proto_services.ImportCarsRequest{
+		{Format: proto_services.CarDataFormat_CAR_DATA_FORMAT_CSV, Chunk: []byte("id,brand,productionyear,isrunning\n" + id + ",Audi,20")},
+		{Chunk: []byte("01,true\n,BMW,1900,false\n" + id + ",Audi,2001,true\n,Kia,2015,\n,Lada,old,false\n")},
+	}}
+	err := GRPCHandl.ImportCars(stream)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stream.summary.Imported)
+	require.Equal(t, int64(1), stream.summary.Skipped)
+	require.Equal(t, int64(2), stream.summary.Failed)
+	require.Len(t, stream.summary.Issues, 3)
+	require.Equal(t, int64(3), stream.summary.Issues[0].Line)
+	require.Equal(t, proto_services.CarImportStatus_CAR_IMPORT_FAILED, stream.summary.Issues[0].Status)
+	require.Equal(t, int64(4), stream.summary.Issues[1].Line)
+	require.Equal(t, proto_services.CarImportStatus_CAR_IMPORT_SKIPPED, stream.summary.Issues[1].Status)
+	require.Equal(t, int64(6), stream.summary.Issues[2].Line)
+	servCar.AssertExpectations(t)
+}
+
+func TestImportCarsNDJSONDryRun(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Import", mock.Anything, mock.AnythingOfType("[]*model.CarImportRow"), true).
+		Run(markImported).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	stream := &importCarsStream{requests: []*proto_services.ImportCarsRequest{{
+		Format: proto_services.CarDataFormat_CAR_DATA_FORMAT_NDJSON,
+		DryRun: true,
+		Chunk:  []byte("{\"brand\":\"Audi\",\"productionyear\":2001}\n\nnot json\n{\"brand\":\"Kia\",\"productionyear\":2015}"),
+	}}}
+	err := GRPCHandl.ImportCars(stream)
+	require.NoError(t, err)
+	require.True(t, stream.summary.DryRun)
+	require.Equal(t, int64(2), stream.summary.Imported)
+	require.Equal(t, int64(1), stream.summary.Failed)
+	require.Equal(t, int64(3), stream.summary.Issues[0].Line)
+	servCar.AssertExpectations(t)
+}
+
+func TestImportCarsMissingColumn(t *testing.T) {
+	servCar := new(mocks.CarService)
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	stream := &importCarsStream{requests: []*proto_services.ImportCarsRequest{
+		{Format: proto_services.CarDataFormat_CAR_DATA_FORMAT_CSV, Chunk: []byte("brand,isrunning\nAudi,true\n")},
+	}}
+	err := GRPCHandl.ImportCars(stream)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// exportCarsStream is a fake server stream of the ExportCars call which collects the sent bytes.
+type exportCarsStream struct {
+	grpc.ServerStream
+	data []byte
+}
+
+func (s *exportCarsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportCarsStream) Send(resp *proto_services.ExportCarsResponse) error {
+	s.data = append(s.data, resp.Chunk...)
+	return nil
+}
+
+func TestExportCarsCSV(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Stream", mock.Anything, mock.MatchedBy(func(filter *model.CarFilter) bool {
+		return filter.Brand == testModel.Brand
+	}), mock.Anything).
+		Run(func(args mock.Arguments) {
+			send := args.Get(2).(func(*model.Car) error)
+			require.NoError(t, send(&testModel))
+		}).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	stream := &exportCarsStream{}
+	err := GRPCHandl.ExportCars(&proto_services.ExportCarsRequest{Brand: testModel.Brand}, stream)
+	require.NoError(t, err)
+	expected := fmt.Sprintf("id,brand,productionyear,isrunning,ownerid,version,vin,model,mileage,color,price\n%s,%s,%d,%t,%s,%d,,,0,,0\n",
+		testModel.ID, testModel.Brand, testModel.ProductionYear, testModel.IsRunning, testModel.OwnerID, testModel.Version)
+	require.Equal(t, expected, string(stream.data))
+	servCar.AssertExpectations(t)
+}
+
+func TestExportCarsNDJSON(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Stream", mock.Anything, mock.AnythingOfType("*model.CarFilter"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			send := args.Get(2).(func(*model.Car) error)
+			require.NoError(t, send(&testModel))
+			require.NoError(t, send(&testModel))
+		}).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	stream := &exportCarsStream{}
+	err := GRPCHandl.ExportCars(&proto_services.ExportCarsRequest{Format: proto_services.CarDataFormat_CAR_DATA_FORMAT_NDJSON}, stream)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(stream.data)), "\n")
+	require.Len(t, lines, 2)
+	var car model.Car
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &car))
+	require.Equal(t, testModel.ID, car.ID)
+	require.Equal(t, testModel.Brand, car.Brand)
 	servCar.AssertExpectations(t)
 }
 
@@ -118,7 +663,7 @@ func TestSignUpUser(t *testing.T) {
 	servUser.On("SignUpUser", mock.Anything, mock.AnythingOfType("*model.User")).
 		Return("accessToken", "refreshToken", nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(nil, servUser, validator.New())
+	GRPCHandl := NewGRPCHandler(nil, servUser, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.SignUpUser(context.Background(), &proto_services.SignUpUserRequest{Login: "testUser", Password: "testUser"})
 	require.NoError(t, err)
 	require.Equal(t, protoResponse.AccessToken, "accessToken")
@@ -131,7 +676,7 @@ func TestSignUpAdmin(t *testing.T) {
 	servUser.On("SignUpUser", mock.Anything, mock.AnythingOfType("*model.User")).
 		Return("accessToken", "refreshToken", nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(nil, servUser, validator.New())
+	GRPCHandl := NewGRPCHandler(nil, servUser, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.SignUpAdmin(context.Background(), &proto_services.SignUpAdminRequest{Login: "testUser", Password: "testUser"})
 	require.NoError(t, err)
 	require.Equal(t, protoResponse.AccessToken, "accessToken")
@@ -144,7 +689,7 @@ func TestGetByLogin(t *testing.T) {
 	servUser.On("GetByLogin", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
 		Return("accessToken", "refreshToken", nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(nil, servUser, validator.New())
+	GRPCHandl := NewGRPCHandler(nil, servUser, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.GetByLogin(context.Background(), &proto_services.GetByLoginRequest{Login: "testUser", Password: "testUser"})
 	require.NoError(t, err)
 	require.Equal(t, protoResponse.AccessToken, "accessToken")
@@ -157,10 +702,405 @@ func TestRefresh(t *testing.T) {
 	servUser.On("RefreshToken", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
 		Return("accessToken", "refreshToken", nil).
 		Once()
-	GRPCHandl := NewGRPCHandler(nil, servUser, validator.New())
+	GRPCHandl := NewGRPCHandler(nil, servUser, nil, nil, nil, nil, validation.New(&testConfig))
 	protoResponse, err := GRPCHandl.RefreshToken(context.Background(), &proto_services.RefreshTokenRequest{AccessToken: "testAccess", RefreshToken: "testRefresh"})
 	require.NoError(t, err)
 	require.Equal(t, protoResponse.AccessToken, "accessToken")
 	require.Equal(t, protoResponse.RefreshToken, "refreshToken")
 	servUser.AssertExpectations(t)
 }
+
+type watchCarsStream struct {
+	grpc.ServerStream
+	changes []*proto_services.CarChange
+}
+
+func (s *watchCarsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *watchCarsStream) Send(change *proto_services.CarChange) error {
+	s.changes = append(s.changes, change)
+	return nil
+}
+
+func TestWatchCars(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Watch", mock.Anything, mock.MatchedBy(func(filter *model.CarWatchFilter) bool {
+		return filter.Brand == testModel.Brand && filter.CarID == testModel.ID
+	}), mock.Anything).
+		Run(func(args mock.Arguments) {
+			send := args.Get(2).(func(*model.CarChange) error)
+			require.NoError(t, send(&model.CarChange{ID: uuid.New(), CarID: testModel.ID, Action: model.CarCreated, After: &testModel}))
+			require.NoError(t, send(&model.CarChange{ID: uuid.New(), CarID: testModel.ID, Action: model.CarDeleted, Before: &testModel}))
+		}).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	stream := &watchCarsStream{}
+	err := GRPCHandl.WatchCars(&proto_services.WatchCarsRequest{
+		Brand: testModel.Brand,
+		CarID: &proto_services.UUID{Value: testModel.ID.String()},
+	}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.changes, 2)
+	require.Equal(t, proto_services.CarChangeAction_CAR_CREATED, stream.changes[0].Action)
+	require.Equal(t, testModel.Brand, stream.changes[0].After.Brand)
+	require.Equal(t, proto_services.CarChangeAction_CAR_DELETED, stream.changes[1].Action)
+	servCar.AssertExpectations(t)
+}
+
+func TestWatchCarsInvalidCarID(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(new(mocks.CarService), nil, nil, nil, nil, nil, validation.New(&testConfig))
+	err := GRPCHandl.WatchCars(&proto_services.WatchCarsRequest{CarID: &proto_services.UUID{Value: "not-a-uuid"}}, &watchCarsStream{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetCarStats(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Stats", mock.Anything).
+		Return(&model.CarStats{
+			Total:           4,
+			Running:         3,
+			Brands:          []*model.BrandCount{{Brand: "Audi", Count: 3}, {Brand: "BMW", Count: 1}},
+			ProductionYears: []*model.ProductionYearCount{{Year: 2010, Count: 4}},
+		}, nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.GetCarStats(context.Background(), &proto_services.GetCarStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), resp.Total)
+	require.Equal(t, int64(1), resp.NotRunning)
+	require.Equal(t, 0.75, resp.RunningShare)
+	require.Equal(t, 0.25, resp.NotRunningShare)
+	require.Len(t, resp.Brands, 2)
+	require.Equal(t, "BMW", resp.Brands[1].Brand)
+	require.Equal(t, int64(2010), resp.ProductionYears[0].Year)
+	servCar.AssertExpectations(t)
+}
+
+func TestGetCarStatsNoCars(t *testing.T) {
+	servCar := new(mocks.CarService)
+	servCar.On("Stats", mock.Anything).Return(&model.CarStats{}, nil).Once()
+	GRPCHandl := NewGRPCHandler(servCar, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.GetCarStats(context.Background(), &proto_services.GetCarStatsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, resp.RunningShare)
+	require.Zero(t, resp.NotRunningShare)
+	servCar.AssertExpectations(t)
+}
+
+type uploadImageStream struct {
+	grpc.ServerStream
+	reqs []*proto_services.UploadImageRequest
+	resp *proto_services.UploadImageResponse
+}
+
+func (s *uploadImageStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *uploadImageStream) Recv() (*proto_services.UploadImageRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *uploadImageStream) SendAndClose(resp *proto_services.UploadImageResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestUploadImage(t *testing.T) {
+	servImage := new(mocks.CarImageService)
+	image := &model.CarImage{ID: uuid.New(), CarID: testModel.ID, ContentType: "image/png", Size: 6, Position: 1}
+	servImage.On("Upload", mock.Anything, testModel.ID, mock.Anything).
+		Run(func(args mock.Arguments) {
+			data, err := io.ReadAll(args.Get(2).(io.Reader))
+			require.NoError(t, err)
+			require.Equal(t, "abcdef", string(data))
+		}).
+		Return(image, nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, servImage, nil, nil, nil, validation.New(&testConfig))
+	stream := &uploadImageStream{reqs: []*proto_services.UploadImageRequest{
+		{CarID: &proto_services.UUID{Value: testModel.ID.String()}, Img: []byte("abc")},
+		{Img: []byte("def")},
+	}}
+	err := GRPCHandl.UploadImage(stream)
+	require.NoError(t, err)
+	require.Equal(t, image.ID.String(), stream.resp.Image.ID.Value)
+	require.Equal(t, int64(1), stream.resp.Image.Order)
+	require.Equal(t, "upload/"+image.ID.String()+".png", stream.resp.Image.ImgName)
+	servImage.AssertExpectations(t)
+}
+
+func TestUploadImageUnsupportedType(t *testing.T) {
+	servImage := new(mocks.CarImageService)
+	servImage.On("Upload", mock.Anything, testModel.ID, mock.Anything).
+		Return(nil, fmt.Errorf("CarImageEntity-Upload: %w", model.ErrUnsupportedImageType)).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, servImage, nil, nil, nil, validation.New(&testConfig))
+	stream := &uploadImageStream{reqs: []*proto_services.UploadImageRequest{
+		{CarID: &proto_services.UUID{Value: testModel.ID.String()}, Img: []byte("plain text")},
+	}}
+	err := GRPCHandl.UploadImage(stream)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	servImage.AssertExpectations(t)
+}
+
+func TestUploadImageWithoutCarID(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(nil, nil, new(mocks.CarImageService), nil, nil, nil, validation.New(&testConfig))
+	err := GRPCHandl.UploadImage(&uploadImageStream{reqs: []*proto_services.UploadImageRequest{{Img: []byte("abc")}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListCarImages(t *testing.T) {
+	servImage := new(mocks.CarImageService)
+	images := []*model.CarImage{
+		{ID: uuid.New(), CarID: testModel.ID, ContentType: "image/jpeg", Position: 0},
+		{ID: uuid.New(), CarID: testModel.ID, ContentType: "image/png", Position: 1},
+	}
+	servImage.On("List", mock.Anything, testModel.ID).
+		Return(images, nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, servImage, nil, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.ListCarImages(context.Background(), &proto_services.ListCarImagesRequest{
+		CarID: &proto_services.UUID{Value: testModel.ID.String()},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Images, 2)
+	require.Equal(t, images[0].ID.String(), resp.Images[0].ID.Value)
+	require.Equal(t, "image/png", resp.Images[1].ContentType)
+	servImage.AssertExpectations(t)
+}
+
+func TestSetPrimaryCarImageNotFound(t *testing.T) {
+	servImage := new(mocks.CarImageService)
+	imageID := uuid.New()
+	servImage.On("SetPrimary", mock.Anything, testModel.ID, imageID).
+		Return(nil, fmt.Errorf("CarImageEntity-SetPrimary: %w", model.ErrCarImageNotFound)).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, servImage, nil, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.SetPrimaryCarImage(context.Background(), &proto_services.SetPrimaryCarImageRequest{
+		CarID:   &proto_services.UUID{Value: testModel.ID.String()},
+		ImageID: &proto_services.UUID{Value: imageID.String()},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	servImage.AssertExpectations(t)
+}
+
+// downloadImageStream is a fake server stream of the DownloadImage call which collects the sent bytes.
+type downloadImageStream struct {
+	grpc.ServerStream
+	data []byte
+}
+
+func (s *downloadImageStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *downloadImageStream) Send(resp *proto_services.DownloadImageResponse) error {
+	s.data = append(s.data, resp.Img...)
+	return nil
+}
+
+func TestDownloadImageOutsideImages(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, nil, nil, validation.New(&testConfig))
+	stream := &downloadImageStream{}
+	// the path leads from the images directory of the handler package to go.mod of the module
+	err := GRPCHandl.DownloadImage(&proto_services.DownloadImageRequest{ImgName: "../../../go.mod"}, stream)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Empty(t, stream.data)
+}
+
+func TestAddMaintenance(t *testing.T) {
+	servMaintenance := new(mocks.MaintenanceService)
+	performedAt := time.Date(2023, time.May, 4, 10, 0, 0, 0, time.UTC)
+	servMaintenance.On("Add", mock.Anything, mock.MatchedBy(func(record *model.MaintenanceRecord) bool {
+		return record.CarID == testModel.ID && record.Kind == model.MaintenanceRepair && record.Critical &&
+			record.PerformedAt.Equal(performedAt) && record.ClosedAt == nil
+	})).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, servMaintenance, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.AddMaintenance(context.Background(), &proto_services.AddMaintenanceRequest{
+		Record: &proto_services.MaintenanceRecord{
+			CarID:       &proto_services.UUID{Value: testModel.ID.String()},
+			Kind:        proto_services.MaintenanceKind_MAINTENANCE_REPAIR,
+			PerformedAt: timestamppb.New(performedAt),
+			Mileage:     52000,
+			Cost:        45000,
+			Notes:       "brake failure",
+			Critical:    true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, proto_services.MaintenanceKind_MAINTENANCE_REPAIR, resp.Record.Kind)
+	require.Nil(t, resp.Record.ClosedAt)
+	servMaintenance.AssertExpectations(t)
+}
+
+func TestAddMaintenanceWithoutKind(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, new(mocks.MaintenanceService), nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.AddMaintenance(context.Background(), &proto_services.AddMaintenanceRequest{
+		Record: &proto_services.MaintenanceRecord{
+			CarID:       &proto_services.UUID{Value: testModel.ID.String()},
+			PerformedAt: timestamppb.Now(),
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCloseMaintenanceNotFound(t *testing.T) {
+	servMaintenance := new(mocks.MaintenanceService)
+	id := uuid.New()
+	servMaintenance.On("Close", mock.Anything, id).
+		Return(nil, fmt.Errorf("MaintenanceEntity-Close: %w", model.ErrMaintenanceNotFound)).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, servMaintenance, nil, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.CloseMaintenance(context.Background(), &proto_services.CloseMaintenanceRequest{ID: &proto_services.UUID{Value: id.String()}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	servMaintenance.AssertExpectations(t)
+}
+
+func TestGetCarsDueForService(t *testing.T) {
+	servMaintenance := new(mocks.MaintenanceService)
+	servicedAt := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	servMaintenance.On("DueForService", mock.Anything).
+		Return([]*model.ServiceDue{
+			{Car: &testModel},
+			{Car: &testModel, LastServicedAt: &servicedAt, LastServiceMileage: 30000},
+		}, nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, servMaintenance, nil, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.GetCarsDueForService(context.Background(), &proto_services.GetCarsDueForServiceRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Cars, 2)
+	require.Nil(t, resp.Cars[0].LastServicedAt)
+	require.Equal(t, servicedAt, resp.Cars[1].LastServicedAt.AsTime())
+	require.Equal(t, int64(30000), resp.Cars[1].LastServiceMileage)
+	servMaintenance.AssertExpectations(t)
+}
+
+func TestCreateReservation(t *testing.T) {
+	servReservation := new(mocks.ReservationService)
+	startsAt := time.Date(2023, time.June, 1, 9, 0, 0, 0, time.UTC)
+	servReservation.On("Create", mock.Anything, mock.MatchedBy(func(reservation *model.Reservation) bool {
+		return reservation.CarID == testModel.ID && reservation.StartsAt.Equal(startsAt) && reservation.EndsAt.Equal(startsAt.Add(time.Hour))
+	})).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, servReservation, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.CreateReservation(context.Background(), &proto_services.CreateReservationRequest{
+		CarID:    &proto_services.UUID{Value: testModel.ID.String()},
+		StartsAt: timestamppb.New(startsAt),
+		EndsAt:   timestamppb.New(startsAt.Add(time.Hour)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, startsAt, resp.Reservation.StartsAt.AsTime())
+	require.Nil(t, resp.Reservation.CanceledAt)
+	servReservation.AssertExpectations(t)
+}
+
+func TestCreateReservationEndsBeforeStart(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, new(mocks.ReservationService), nil, validation.New(&testConfig))
+	startsAt := time.Date(2023, time.June, 1, 9, 0, 0, 0, time.UTC)
+	_, err := GRPCHandl.CreateReservation(context.Background(), &proto_services.CreateReservationRequest{
+		CarID:    &proto_services.UUID{Value: testModel.ID.String()},
+		StartsAt: timestamppb.New(startsAt),
+		EndsAt:   timestamppb.New(startsAt.Add(-time.Hour)),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateReservationConflict(t *testing.T) {
+	servReservation := new(mocks.ReservationService)
+	servReservation.On("Create", mock.Anything, mock.AnythingOfType("*model.Reservation")).
+		Return(fmt.Errorf("ReservationEntity-Create: %w", model.ErrReservationConflict)).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, servReservation, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.CreateReservation(context.Background(), &proto_services.CreateReservationRequest{
+		CarID:    &proto_services.UUID{Value: testModel.ID.String()},
+		StartsAt: timestamppb.Now(),
+		EndsAt:   timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	servReservation.AssertExpectations(t)
+}
+
+func TestCancelReservationNotFound(t *testing.T) {
+	servReservation := new(mocks.ReservationService)
+	id := uuid.New()
+	servReservation.On("Cancel", mock.Anything, id).
+		Return(nil, fmt.Errorf("ReservationEntity-Cancel: %w", model.ErrReservationNotFound)).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, servReservation, nil, validation.New(&testConfig))
+	_, err := GRPCHandl.CancelReservation(context.Background(), &proto_services.CancelReservationRequest{ID: &proto_services.UUID{Value: id.String()}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	servReservation.AssertExpectations(t)
+}
+
+func TestListUserReservations(t *testing.T) {
+	servReservation := new(mocks.ReservationService)
+	reservation := &model.Reservation{ID: uuid.New(), CarID: testModel.ID, UserID: uuid.New(), StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour)}
+	servReservation.On("ListByUser", mock.Anything, uuid.Nil, false).
+		Return([]*model.Reservation{reservation}, nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, servReservation, nil, validation.New(&testConfig))
+	resp, err := GRPCHandl.ListUserReservations(context.Background(), &proto_services.ListUserReservationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Reservations, 1)
+	require.Equal(t, reservation.ID.String(), resp.Reservations[0].ID.Value)
+	servReservation.AssertExpectations(t)
+}
+
+func TestCreateBrand(t *testing.T) {
+	servBrand := new(mocks.BrandService)
+	servBrand.On("Create", mock.Anything, mock.MatchedBy(func(brand *model.Brand) bool {
+		return brand.Name == "Volkswagen" && len(brand.Aliases) == 2
+	})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.Brand).ID = testModel.ID
+		}).
+		Return(nil).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, nil, servBrand, validation.New(&testConfig))
+	resp, err := GRPCHandl.CreateBrand(context.Background(), &proto_services.CreateBrandRequest{Name: "Volkswagen", Aliases: []string{"VW", "Volks"}})
+	require.NoError(t, err)
+	require.Equal(t, testModel.ID.String(), resp.Brand.ID.Value)
+	require.Equal(t, []string{"VW", "Volks"}, resp.Brand.Aliases)
+	servBrand.AssertExpectations(t)
+}
+
+func TestCreateBrandWithoutName(t *testing.T) {
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, nil, new(mocks.BrandService), validation.New(&testConfig))
+	_, err := GRPCHandl.CreateBrand(context.Background(), &proto_services.CreateBrandRequest{Aliases: []string{"VW"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateBrandDuplicate(t *testing.T) {
+	servBrand := new(mocks.BrandService)
+	servBrand.On("Create", mock.Anything, mock.AnythingOfType("*model.Brand")).
+		Return(fmt.Errorf("BrandEntity-Create: %w", model.ErrDuplicateBrand)).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, nil, servBrand, validation.New(&testConfig))
+	_, err := GRPCHandl.CreateBrand(context.Background(), &proto_services.CreateBrandRequest{Name: "VW"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	servBrand.AssertExpectations(t)
+}
+
+func TestDeleteBrandInUse(t *testing.T) {
+	servBrand := new(mocks.BrandService)
+	id := uuid.New()
+	servBrand.On("Delete", mock.Anything, id).
+		Return(fmt.Errorf("BrandEntity-Delete: %w", model.ErrBrandInUse)).
+		Once()
+	GRPCHandl := NewGRPCHandler(nil, nil, nil, nil, nil, servBrand, validation.New(&testConfig))
+	_, err := GRPCHandl.DeleteBrand(context.Background(), &proto_services.DeleteBrandRequest{ID: &proto_services.UUID{Value: id.String()}})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	servBrand.AssertExpectations(t)
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/proto_services"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddMaintenance handles the request to record maintenance of a car, the record stays open unless it is added as closed.
+func (h *GRPCHandler) AddMaintenance(ctx context.Context, req *proto_services.AddMaintenanceRequest) (*proto_services.AddMaintenanceResponse, error) {
+	carID, err := uuid.Parse(req.GetRecord().GetCarID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.AddMaintenanceResponse{}, status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	record := &model.MaintenanceRecord{
+		ID:       uuid.New(),
+		CarID:    carID,
+		Kind:     maintenanceKindsFromProto[req.Record.Kind],
+		Mileage:  req.Record.Mileage,
+		Cost:     req.Record.Cost,
+		Notes:    req.Record.Notes,
+		Critical: req.Record.Critical,
+	}
+	if req.Record.PerformedAt != nil {
+		record.PerformedAt = req.Record.PerformedAt.AsTime().Truncate(time.Millisecond)
+	}
+	if req.Closed {
+		closedAt := time.Now().UTC().Truncate(time.Millisecond)
+		record.ClosedAt = &closedAt
+	}
+	err = h.validate.StructCtx(ctx, record)
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return &proto_services.AddMaintenanceResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = h.maintenanceService.Add(ctx, record)
+	if err != nil {
+		log.WithField(
+			"CarID", carID,
+		).Errorf("failed to add maintenance: %v", err)
+		return &proto_services.AddMaintenanceResponse{}, statusError(err)
+	}
+	return &proto_services.AddMaintenanceResponse{Record: maintenanceToProto(record)}, nil
+}
+
+// CloseMaintenance handles the request to mark an open maintenance record as done.
+func (h *GRPCHandler) CloseMaintenance(ctx context.Context, req *proto_services.CloseMaintenanceRequest) (*proto_services.CloseMaintenanceResponse, error) {
+	id, err := uuid.Parse(req.GetID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.CloseMaintenanceResponse{}, status.Error(codes.InvalidArgument, "maintenance ID must be a UUID")
+	}
+	record, err := h.maintenanceService.Close(ctx, id)
+	if err != nil {
+		log.WithField(
+			"ID", id,
+		).Errorf("failed to close maintenance: %v", err)
+		return &proto_services.CloseMaintenanceResponse{}, statusError(err)
+	}
+	return &proto_services.CloseMaintenanceResponse{Record: maintenanceToProto(record)}, nil
+}
+
+// ListMaintenance handles the request to list the maintenance records of a car, the latest work comes first.
+func (h *GRPCHandler) ListMaintenance(ctx context.Context, req *proto_services.ListMaintenanceRequest) (*proto_services.ListMaintenanceResponse, error) {
+	carID, err := uuid.Parse(req.GetCarID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.ListMaintenanceResponse{}, status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	records, err := h.maintenanceService.List(ctx, carID)
+	if err != nil {
+		log.WithField(
+			"CarID", carID,
+		).Errorf("failed to list maintenance: %v", err)
+		return &proto_services.ListMaintenanceResponse{}, statusError(err)
+	}
+	protoRecords := make([]*proto_services.MaintenanceRecord, 0, len(records))
+	for _, record := range records {
+		protoRecords = append(protoRecords, maintenanceToProto(record))
+	}
+	return &proto_services.ListMaintenanceResponse{Records: protoRecords}, nil
+}
+
+// GetCarsDueForService handles the request to list the cars which are due for the routine service.
+func (h *GRPCHandler) GetCarsDueForService(ctx context.Context, _ *proto_services.GetCarsDueForServiceRequest) (*proto_services.GetCarsDueForServiceResponse, error) {
+	due, err := h.maintenanceService.DueForService(ctx)
+	if err != nil {
+		log.Errorf("failed to get cars due for service: %v", err)
+		return &proto_services.GetCarsDueForServiceResponse{}, statusError(err)
+	}
+	protoDue := make([]*proto_services.ServiceDue, 0, len(due))
+	for _, item := range due {
+		protoItem := &proto_services.ServiceDue{Car: carToProto(item.Car), LastServiceMileage: item.LastServiceMileage}
+		if item.LastServicedAt != nil {
+			protoItem.LastServicedAt = timestamppb.New(*item.LastServicedAt)
+		}
+		protoDue = append(protoDue, protoItem)
+	}
+	return &proto_services.GetCarsDueForServiceResponse{Cars: protoDue}, nil
+}
+
+// maintenanceKinds maps the kinds of maintenance to their proto values.
+var maintenanceKinds = map[model.MaintenanceKind]proto_services.MaintenanceKind{
+	model.MaintenanceService:    proto_services.MaintenanceKind_MAINTENANCE_SERVICE,
+	model.MaintenanceRepair:     proto_services.MaintenanceKind_MAINTENANCE_REPAIR,
+	model.MaintenanceInspection: proto_services.MaintenanceKind_MAINTENANCE_INSPECTION,
+	model.MaintenanceTires:      proto_services.MaintenanceKind_MAINTENANCE_TIRES,
+}
+
+// maintenanceKindsFromProto maps the proto values of the kinds of maintenance back, the unspecified kind maps to an empty one.
+var maintenanceKindsFromProto = map[proto_services.MaintenanceKind]model.MaintenanceKind{
+	proto_services.MaintenanceKind_MAINTENANCE_SERVICE:    model.MaintenanceService,
+	proto_services.MaintenanceKind_MAINTENANCE_REPAIR:     model.MaintenanceRepair,
+	proto_services.MaintenanceKind_MAINTENANCE_INSPECTION: model.MaintenanceInspection,
+	proto_services.MaintenanceKind_MAINTENANCE_TIRES:      model.MaintenanceTires,
+}
+
+// maintenanceToProto converts a maintenance record into its proto message.
+func maintenanceToProto(record *model.MaintenanceRecord) *proto_services.MaintenanceRecord {
+	protoRecord := &proto_services.MaintenanceRecord{
+		ID:          &proto_services.UUID{Value: record.ID.String()},
+		CarID:       &proto_services.UUID{Value: record.CarID.String()},
+		Kind:        maintenanceKinds[record.Kind],
+		PerformedAt: timestamppb.New(record.PerformedAt),
+		Mileage:     record.Mileage,
+		Cost:        record.Cost,
+		Notes:       record.Notes,
+		Critical:    record.Critical,
+	}
+	if record.ClosedAt != nil {
+		protoRecord.ClosedAt = timestamppb.New(*record.ClosedAt)
+	}
+	return protoRecord
+}
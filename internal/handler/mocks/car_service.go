@@ -17,6 +17,48 @@ type CarService struct {
 	mock.Mock
 }
 
+// BatchCreate provides a mock function with given fields: ctx, cars
+func (_m *CarService) BatchCreate(ctx context.Context, cars []*model.Car) error {
+	ret := _m.Called(ctx, cars)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Car) error); ok {
+		r0 = rf(ctx, cars)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BatchDelete provides a mock function with given fields: ctx, ids
+func (_m *CarService) BatchDelete(ctx context.Context, ids []uuid.UUID) error {
+	ret := _m.Called(ctx, ids)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BatchUpdate provides a mock function with given fields: ctx, updates
+func (_m *CarService) BatchUpdate(ctx context.Context, updates []*model.CarUpdate) error {
+	ret := _m.Called(ctx, updates)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.CarUpdate) error); ok {
+		r0 = rf(ctx, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, car
 func (_m *CarService) Create(ctx context.Context, car *model.Car) error {
 	ret := _m.Called(ctx, car)
@@ -71,20 +113,176 @@ func (_m *CarService) Get(ctx context.Context, id uuid.UUID) (*model.Car, error)
 	return r0, r1
 }
 
-// GetAll provides a mock function with given fields: ctx
-func (_m *CarService) GetAll(ctx context.Context) ([]*model.Car, error) {
-	ret := _m.Called(ctx)
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *CarService) GetAll(ctx context.Context, filter *model.CarFilter) ([]*model.Car, string, error) {
+	ret := _m.Called(ctx, filter)
 
 	var r0 []*model.Car
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CarFilter) ([]*model.Car, string, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CarFilter) []*model.Car); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Car)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CarFilter) string); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.CarFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByVIN provides a mock function with given fields: ctx, vin
+func (_m *CarService) GetByVIN(ctx context.Context, vin string) (*model.Car, error) {
+	ret := _m.Called(ctx, vin)
+
+	var r0 *model.Car
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Car, error)); ok {
+		return rf(ctx, vin)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Car); ok {
+		r0 = rf(ctx, vin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Car)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, vin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistory provides a mock function with given fields: ctx, filter
+func (_m *CarService) GetHistory(ctx context.Context, filter *model.CarHistoryFilter) ([]*model.CarChange, string, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []*model.CarChange
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CarHistoryFilter) ([]*model.CarChange, string, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CarHistoryFilter) []*model.CarChange); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CarChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CarHistoryFilter) string); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.CarHistoryFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Import provides a mock function with given fields: ctx, rows, dryRun
+func (_m *CarService) Import(ctx context.Context, rows []*model.CarImportRow, dryRun bool) error {
+	ret := _m.Called(ctx, rows, dryRun)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.CarImportRow, bool) error); ok {
+		r0 = rf(ctx, rows, dryRun)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeDeleted provides a mock function with given fields: ctx
+func (_m *CarService) PurgeDeleted(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Car, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*model.Car); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
 		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *CarService) Restore(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Car
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Car, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Car); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Car)
+			r0 = ret.Get(0).(*model.Car)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Stats provides a mock function with given fields: ctx
+func (_m *CarService) Stats(ctx context.Context) (*model.CarStats, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.CarStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.CarStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.CarStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CarStats)
 		}
 	}
 
@@ -97,13 +295,41 @@ func (_m *CarService) GetAll(ctx context.Context) ([]*model.Car, error) {
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, car
-func (_m *CarService) Update(ctx context.Context, car *model.Car) error {
-	ret := _m.Called(ctx, car)
+// Stream provides a mock function with given fields: ctx, filter, send
+func (_m *CarService) Stream(ctx context.Context, filter *model.CarFilter, send func(car *model.Car) error) error {
+	ret := _m.Called(ctx, filter, send)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Car) error); ok {
-		r0 = rf(ctx, car)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CarFilter, func(car *model.Car) error) error); ok {
+		r0 = rf(ctx, filter, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, car, fields
+func (_m *CarService) Update(ctx context.Context, car *model.Car, fields []string) error {
+	ret := _m.Called(ctx, car, fields)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Car, []string) error); ok {
+		r0 = rf(ctx, car, fields)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Watch provides a mock function with given fields: ctx, filter, send
+func (_m *CarService) Watch(ctx context.Context, filter *model.CarWatchFilter, send func(change *model.CarChange) error) error {
+	ret := _m.Called(ctx, filter, send)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CarWatchFilter, func(change *model.CarChange) error) error); ok {
+		r0 = rf(ctx, filter, send)
 	} else {
 		r0 = ret.Error(0)
 	}
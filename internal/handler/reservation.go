@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/proto_services"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateReservation handles the request to reserve a car for a time window.
+func (h *GRPCHandler) CreateReservation(ctx context.Context, req *proto_services.CreateReservationRequest) (*proto_services.CreateReservationResponse, error) {
+	carID, err := uuid.Parse(req.GetCarID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.CreateReservationResponse{}, status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	reservation := &model.Reservation{
+		ID:    uuid.New(),
+		CarID: carID,
+	}
+	if req.StartsAt != nil {
+		reservation.StartsAt = req.StartsAt.AsTime().Truncate(time.Millisecond)
+	}
+	if req.EndsAt != nil {
+		reservation.EndsAt = req.EndsAt.AsTime().Truncate(time.Millisecond)
+	}
+	err = h.validate.StructCtx(ctx, reservation)
+	if err != nil {
+		log.Errorf("failed to validate error: %v", err)
+		return &proto_services.CreateReservationResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = h.reservationService.Create(ctx, reservation)
+	if err != nil {
+		log.WithField(
+			"CarID", carID,
+		).Errorf("failed to create reservation: %v", err)
+		return &proto_services.CreateReservationResponse{}, statusError(err)
+	}
+	return &proto_services.CreateReservationResponse{Reservation: reservationToProto(reservation)}, nil
+}
+
+// CancelReservation handles the request to cancel an active reservation, the window becomes free for new reservations.
+func (h *GRPCHandler) CancelReservation(ctx context.Context, req *proto_services.CancelReservationRequest) (*proto_services.CancelReservationResponse, error) {
+	id, err := uuid.Parse(req.GetID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.CancelReservationResponse{}, status.Error(codes.InvalidArgument, "reservation ID must be a UUID")
+	}
+	reservation, err := h.reservationService.Cancel(ctx, id)
+	if err != nil {
+		log.WithField(
+			"ID", id,
+		).Errorf("failed to cancel reservation: %v", err)
+		return &proto_services.CancelReservationResponse{}, statusError(err)
+	}
+	return &proto_services.CancelReservationResponse{Reservation: reservationToProto(reservation)}, nil
+}
+
+// ListCarReservations handles the request to list the reservations of a car in the order of their start.
+func (h *GRPCHandler) ListCarReservations(ctx context.Context, req *proto_services.ListCarReservationsRequest) (*proto_services.ListReservationsResponse, error) {
+	carID, err := uuid.Parse(req.GetCarID().GetValue())
+	if err != nil {
+		log.Errorf("failed to parse error %v", err)
+		return &proto_services.ListReservationsResponse{}, status.Error(codes.InvalidArgument, "car ID must be a UUID")
+	}
+	reservations, err := h.reservationService.ListByCar(ctx, carID, req.IncludeCanceled)
+	if err != nil {
+		log.WithField(
+			"CarID", carID,
+		).Errorf("failed to list car reservations: %v", err)
+		return &proto_services.ListReservationsResponse{}, statusError(err)
+	}
+	return &proto_services.ListReservationsResponse{Reservations: reservationsToProto(reservations)}, nil
+}
+
+// ListUserReservations handles the request to list the reservations made by a user, an empty user ID stands for the caller.
+func (h *GRPCHandler) ListUserReservations(ctx context.Context, req *proto_services.ListUserReservationsRequest) (*proto_services.ListReservationsResponse, error) {
+	userID := uuid.Nil
+	if req.GetUserID().GetValue() != "" {
+		var err error
+		userID, err = uuid.Parse(req.UserID.Value)
+		if err != nil {
+			log.Errorf("failed to parse error %v", err)
+			return &proto_services.ListReservationsResponse{}, status.Error(codes.InvalidArgument, "user ID must be a UUID")
+		}
+	}
+	reservations, err := h.reservationService.ListByUser(ctx, userID, req.IncludeCanceled)
+	if err != nil {
+		log.WithField(
+			"UserID", userID,
+		).Errorf("failed to list user reservations: %v", err)
+		return &proto_services.ListReservationsResponse{}, statusError(err)
+	}
+	return &proto_services.ListReservationsResponse{Reservations: reservationsToProto(reservations)}, nil
+}
+
+// reservationToProto converts a reservation into its proto message.
+func reservationToProto(reservation *model.Reservation) *proto_services.Reservation {
+	protoReservation := &proto_services.Reservation{
+		ID:        &proto_services.UUID{Value: reservation.ID.String()},
+		CarID:     &proto_services.UUID{Value: reservation.CarID.String()},
+		UserID:    &proto_services.UUID{Value: reservation.UserID.String()},
+		StartsAt:  timestamppb.New(reservation.StartsAt),
+		EndsAt:    timestamppb.New(reservation.EndsAt),
+		CreatedAt: timestamppb.New(reservation.CreatedAt),
+	}
+	if reservation.CanceledAt != nil {
+		protoReservation.CanceledAt = timestamppb.New(*reservation.CanceledAt)
+	}
+	return protoReservation
+}
+
+// reservationsToProto converts reservations keeping their order.
+func reservationsToProto(reservations []*model.Reservation) []*proto_services.Reservation {
+	protoReservations := make([]*proto_services.Reservation, 0, len(reservations))
+	for _, reservation := range reservations {
+		protoReservations = append(protoReservations, reservationToProto(reservation))
+	}
+	return protoReservations
+}
@@ -0,0 +1,153 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/internal/service"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// idempotencyKeyHeader is the metadata header the client sends the same key in with every retry of a call
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyWriteTimeout is how long the response to a call is being saved or the key of a failed call is being released,
+// they are written after the call even if the client has gone
+const idempotencyWriteTimeout = 5 * time.Second
+
+// idempotentMethods are the mutating methods of cars whose retries are answered with the response to the first call
+var idempotentMethods = map[string]bool{
+	"/CarService/CreateCar":       true,
+	"/CarService/UpdateCar":       true,
+	"/CarService/DeleteCar":       true,
+	"/CarService/RestoreCar":      true,
+	"/CarService/BatchCreateCars": true,
+	"/CarService/BatchUpdateCars": true,
+	"/CarService/BatchDeleteCars": true,
+}
+
+// IdempotencyStore keeps the responses to the calls with idempotency keys
+type IdempotencyStore interface {
+	ReserveIdempotencyKey(ctx context.Context, key, requestHash string, ttl time.Duration) (*model.IdempotentResponse, error)
+	SaveIdempotentResponse(ctx context.Context, key string, response *model.IdempotentResponse, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, key string) error
+}
+
+// IdempotencyInterceptor replays the response to the first call of a mutating method on the retries with the same Idempotency-Key
+type IdempotencyInterceptor struct {
+	store IdempotencyStore
+	cfg   *config.Config
+}
+
+// NewIdempotencyInterceptor returns the pointer on IdempotencyInterceptor struct
+func NewIdempotencyInterceptor(store IdempotencyStore, cfg *config.Config) *IdempotencyInterceptor {
+	return &IdempotencyInterceptor{store: store, cfg: cfg}
+}
+
+// UnaryInterceptor runs the call with a new idempotency key and stores its response, a retry gets the stored response back.
+// It has to run after the auth check, the keys of different users don't collide.
+// The key is held for cfg.IdempotencyLeaseTTL while the call runs, then the response is kept for cfg.IdempotencyKeyTTL.
+// Failed calls aren't stored, so they can be retried with the same key.
+func (ii *IdempotencyInterceptor) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handl grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(idempotencyKeyHeader)
+	message, ok := req.(proto.Message)
+	if !idempotentMethods[info.FullMethod] || len(keys) == 0 || keys[0] == "" || !ok {
+		return handl(ctx, req)
+	}
+	requestHash, err := hashRequest(message)
+	if err != nil {
+		logrus.Errorf("failed to hash request: %v", err)
+		return nil, status.Error(codes.Internal, "failed to hash request")
+	}
+	identity, _ := service.IdentityFromContext(ctx)
+	key := fmt.Sprintf("%s:%s:%s", identity.UserID, info.FullMethod, keys[0])
+	stored, err := ii.store.ReserveIdempotencyKey(ctx, key, requestHash, ii.cfg.IdempotencyLeaseTTL)
+	if err != nil {
+		logrus.Errorf("failed to reserve idempotency key: %v", err)
+		return nil, status.Error(codes.Unavailable, "failed to check idempotency key")
+	}
+	if stored != nil {
+		return replayResponse(stored, requestHash)
+	}
+	resp, err := handl(ctx, req)
+	// the outcome of the call is written even if the client has canceled it, a retry would wait for the lease to expire otherwise
+	writeCtx, cancel := context.WithTimeout(service.DetachedContext(ctx), idempotencyWriteTimeout)
+	defer cancel()
+	if err != nil {
+		errRelease := ii.store.ReleaseIdempotencyKey(writeCtx, key)
+		if errRelease != nil {
+			logrus.Errorf("failed to release idempotency key: %v", errRelease)
+		}
+		return resp, err
+	}
+	err = ii.saveResponse(writeCtx, key, requestHash, resp)
+	if err != nil {
+		logrus.Errorf("failed to save idempotent response: %v", err)
+		errRelease := ii.store.ReleaseIdempotencyKey(writeCtx, key)
+		if errRelease != nil {
+			logrus.Errorf("failed to release idempotency key: %v", errRelease)
+		}
+	}
+	return resp, nil
+}
+
+// saveResponse stores the response together with its type, so it can be replayed without knowing the method
+func (ii *IdempotencyInterceptor) saveResponse(ctx context.Context, key, requestHash string, resp interface{}) error {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return fmt.Errorf("response %T is not a proto message", resp)
+	}
+	anyResp, err := anypb.New(message)
+	if err != nil {
+		return fmt.Errorf("error in method anypb.New(): %w", err)
+	}
+	data, err := proto.Marshal(anyResp)
+	if err != nil {
+		return fmt.Errorf("error in method proto.Marshal(): %w", err)
+	}
+	return ii.store.SaveIdempotentResponse(ctx, key, &model.IdempotentResponse{RequestHash: requestHash, Response: data}, ii.cfg.IdempotencyKeyTTL)
+}
+
+// replayResponse returns the stored response if the retry comes with the same request as the first call
+func replayResponse(stored *model.IdempotentResponse, requestHash string) (interface{}, error) {
+	if stored.RequestHash != requestHash {
+		return nil, status.Error(codes.InvalidArgument, model.ErrIdempotencyKeyReused.Error())
+	}
+	if len(stored.Response) == 0 {
+		return nil, status.Error(codes.Aborted, model.ErrIdempotentRequestInProgress.Error())
+	}
+	var anyResp anypb.Any
+	err := proto.Unmarshal(stored.Response, &anyResp)
+	if err != nil {
+		logrus.Errorf("failed to unmarshal idempotent response: %v", err)
+		return nil, status.Error(codes.Internal, "failed to replay response")
+	}
+	resp, err := anyResp.UnmarshalNew()
+	if err != nil {
+		logrus.Errorf("failed to unmarshal idempotent response: %v", err)
+		return nil, status.Error(codes.Internal, "failed to replay response")
+	}
+	return resp, nil
+}
+
+// hashRequest hashes the deterministic encoding of the request, equal requests get equal hashes
+func hashRequest(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("error in method proto.Marshal(): %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
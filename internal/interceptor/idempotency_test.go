@@ -0,0 +1,120 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/internal/repository"
+	"github.com/distuurbia/firstTaskArtyom/internal/service"
+	"github.com/distuurbia/firstTaskArtyom/proto_services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var testConfig = config.Config{
+	CarCacheTTL:         time.Minute,
+	CarLocalCacheSize:   100,
+	IdempotencyKeyTTL:   time.Hour,
+	IdempotencyLeaseTTL: time.Minute,
+}
+
+var createCarInfo = &grpc.UnaryServerInfo{FullMethod: "/CarService/CreateCar"}
+
+// idempotentContext is the context of a call of the user with the idempotency key.
+func idempotentContext(userID uuid.UUID, key string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, key))
+	return service.ContextWithIdentity(ctx, model.Identity{UserID: userID})
+}
+
+// createCarHandler answers CreateCar with a car of the requested brand and counts its calls, it fails while fail is set.
+type createCarHandler struct {
+	calls int
+	fail  bool
+}
+
+func (h *createCarHandler) handle(_ context.Context, req interface{}) (interface{}, error) {
+	h.calls++
+	if h.fail {
+		return nil, status.Error(codes.Unavailable, "database is unavailable")
+	}
+	car := req.(*proto_services.CreateCarRequest).Car
+	return &proto_services.CreateCarResponse{Car: &proto_services.Car{ID: &proto_services.UUID{Value: uuid.NewString()}, Brand: car.Brand}}, nil
+}
+
+func TestIdempotencyReplay(t *testing.T) {
+	ii := NewIdempotencyInterceptor(repository.NewMemoryRepository(&testConfig), &testConfig)
+	handler := &createCarHandler{}
+	ctx := idempotentContext(uuid.New(), "key")
+	req := &proto_services.CreateCarRequest{Car: &proto_services.Car{Brand: "Tesla"}}
+
+	first, err := ii.UnaryInterceptor(ctx, req, createCarInfo, handler.handle)
+	require.NoError(t, err)
+	retry, err := ii.UnaryInterceptor(ctx, req, createCarInfo, handler.handle)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(first.(proto.Message), retry.(proto.Message)))
+	require.Equal(t, 1, handler.calls)
+}
+
+func TestIdempotencyKeyReused(t *testing.T) {
+	ii := NewIdempotencyInterceptor(repository.NewMemoryRepository(&testConfig), &testConfig)
+	handler := &createCarHandler{}
+	ctx := idempotentContext(uuid.New(), "key")
+
+	_, err := ii.UnaryInterceptor(ctx, &proto_services.CreateCarRequest{Car: &proto_services.Car{Brand: "Tesla"}}, createCarInfo, handler.handle)
+	require.NoError(t, err)
+	_, err = ii.UnaryInterceptor(ctx, &proto_services.CreateCarRequest{Car: &proto_services.Car{Brand: "Audi"}}, createCarInfo, handler.handle)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, 1, handler.calls)
+}
+
+func TestIdempotencyFailedCallRetried(t *testing.T) {
+	ii := NewIdempotencyInterceptor(repository.NewMemoryRepository(&testConfig), &testConfig)
+	handler := &createCarHandler{fail: true}
+	ctx := idempotentContext(uuid.New(), "key")
+	req := &proto_services.CreateCarRequest{Car: &proto_services.Car{Brand: "Tesla"}}
+
+	_, err := ii.UnaryInterceptor(ctx, req, createCarInfo, handler.handle)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	handler.fail = false
+	_, err = ii.UnaryInterceptor(ctx, req, createCarInfo, handler.handle)
+	require.NoError(t, err)
+	require.Equal(t, 2, handler.calls)
+}
+
+// cancelingStore is an idempotency store which fails the writes made with a canceled context.
+type cancelingStore struct {
+	*repository.MemoryRepository
+}
+
+func (s cancelingStore) SaveIdempotentResponse(ctx context.Context, key string, response *model.IdempotentResponse, ttl time.Duration) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return s.MemoryRepository.SaveIdempotentResponse(ctx, key, response, ttl)
+}
+
+func TestIdempotencyResponseSavedAfterCancel(t *testing.T) {
+	ii := NewIdempotencyInterceptor(cancelingStore{repository.NewMemoryRepository(&testConfig)}, &testConfig)
+	userID := uuid.New()
+	ctx, cancel := context.WithCancel(idempotentContext(userID, "key"))
+	req := &proto_services.CreateCarRequest{Car: &proto_services.Car{Brand: "Tesla"}}
+	handler := &createCarHandler{}
+	// the client goes away while the car is being created
+	_, err := ii.UnaryInterceptor(ctx, req, createCarInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		cancel()
+		return handler.handle(ctx, req)
+	})
+	require.NoError(t, err)
+
+	_, err = ii.UnaryInterceptor(idempotentContext(userID, "key"), req, createCarInfo, handler.handle)
+	require.NoError(t, err)
+	require.Equal(t, 1, handler.calls)
+}
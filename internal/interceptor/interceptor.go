@@ -8,7 +8,10 @@ import (
 	"time"
 
 	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/internal/service"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -32,7 +35,7 @@ func NewCustomInterceptor(cfg *config.Config) *CustomInterceptor {
 func (ci *CustomInterceptor) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handl grpc.UnaryHandler) (interface{}, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	authorization := md.Get("Authorization")
-	if (strings.Contains(info.FullMethod, "/SignUpAdmin") || strings.Contains(info.FullMethod, "/Delete")) && ok && (len(authorization) > 0) {
+	if isAdminMethod(info.FullMethod) && ok && (len(authorization) > 0) {
 		token, err := tokenParse(authorization[0], ci.cfg)
 		if err != nil {
 			logrus.Errorf("failed to parse token: %v", err)
@@ -48,13 +51,18 @@ func (ci *CustomInterceptor) UnaryInterceptor(ctx context.Context, req interface
 			logrus.Error("admin field is false")
 			return nil, status.Errorf(codes.PermissionDenied, "You don't have enough rights: ")
 		}
-		resp, err := handl(ctx, req)
+		identity, err := tokenIdentity(token)
 		if err != nil {
-			logrus.Fatalf("Failed to run handler method: %v", err)
+			logrus.Errorf("failed to read token claims: %v", err)
+			return nil, status.Errorf(codes.Unauthenticated, "failed to read token claims: ")
+		}
+		resp, err := handl(service.ContextWithIdentity(ctx, identity), req)
+		if err != nil {
+			logrus.Errorf("Failed to run handler method: %v", err)
 		}
 		return resp, err
 	}
-	if strings.Contains(info.FullMethod, "/UserService") || strings.Contains(info.FullMethod, "/ImageService") {
+	if strings.Contains(info.FullMethod, "/UserService") {
 		resp, err := handl(ctx, req)
 		if err != nil {
 			logrus.Fatalf("Failed to run handler method: %v", err)
@@ -71,11 +79,16 @@ func (ci *CustomInterceptor) UnaryInterceptor(ctx context.Context, req interface
 		expired := tokenExpCheck(token)
 		if !expired {
 			logrus.Error("Token is expired")
-			return "Token is expired", err
+			return nil, status.Errorf(codes.Unauthenticated, "Token is expired: ")
 		}
-		resp, err := handl(ctx, req)
+		identity, err := tokenIdentity(token)
 		if err != nil {
-			logrus.Fatalf("Failed to run handler method: %v", err)
+			logrus.Errorf("failed to read token claims: %v", err)
+			return nil, status.Errorf(codes.Unauthenticated, "failed to read token claims: ")
+		}
+		resp, err := handl(service.ContextWithIdentity(ctx, identity), req)
+		if err != nil {
+			logrus.Errorf("Failed to run handler method: %v", err)
 		}
 		return resp, err
 	}
@@ -83,6 +96,53 @@ func (ci *CustomInterceptor) UnaryInterceptor(ctx context.Context, req interface
 	return nil, status.Errorf(codes.PermissionDenied, "not found auth token")
 }
 
+// isAdminMethod reports whether only admins may call the method
+func isAdminMethod(fullMethod string) bool {
+	return strings.Contains(fullMethod, "/SignUpAdmin") ||
+		strings.Contains(fullMethod, "/RestoreCar") ||
+		strings.Contains(fullMethod, "/PurgeDeletedCars") ||
+		strings.Contains(fullMethod, "/CreateBrand") ||
+		strings.Contains(fullMethod, "/UpdateBrand") ||
+		strings.Contains(fullMethod, "/DeleteBrand")
+}
+
+// StreamInterceptor checks auth header of streaming calls the same way UnaryInterceptor does for unary ones
+func (ci *CustomInterceptor) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handl grpc.StreamHandler) error {
+	md, ok := metadata.FromIncomingContext(ss.Context())
+	authorization := md.Get("Authorization")
+	if !ok || len(authorization) == 0 {
+		logrus.Error("not found auth token")
+		return status.Errorf(codes.PermissionDenied, "not found auth token")
+	}
+	token, err := tokenParse(authorization[0], ci.cfg)
+	if err != nil {
+		logrus.Errorf("failed to parse token: %v", err)
+		return status.Errorf(codes.Unauthenticated, "failed to parse token error: ")
+	}
+	expired := tokenExpCheck(token)
+	if !expired {
+		logrus.Error("Token is expired")
+		return status.Errorf(codes.Unauthenticated, "Token is expired: ")
+	}
+	identity, err := tokenIdentity(token)
+	if err != nil {
+		logrus.Errorf("failed to read token claims: %v", err)
+		return status.Errorf(codes.Unauthenticated, "failed to read token claims: ")
+	}
+	return handl(srv, &identityStream{ServerStream: ss, ctx: service.ContextWithIdentity(ss.Context(), identity)})
+}
+
+// identityStream is a server stream whose context carries the identity of the caller
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream with the identity of the caller
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
+
 // tokenParse parses token and checks if it valid
 func tokenParse(authorization string, cfg *config.Config) (*jwt.Token, error) {
 	tokenString := strings.TrimPrefix(authorization, "Bearer ")
@@ -109,6 +169,24 @@ func tokenExpCheck(token *jwt.Token) bool {
 	return true
 }
 
+// tokenIdentity reads the id and admin claims of the token
+func tokenIdentity(token *jwt.Token) (model.Identity, error) {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return model.Identity{}, fmt.Errorf("unexpected claims type %T", token.Claims)
+	}
+	idClaim, ok := claims["id"].(string)
+	if !ok {
+		return model.Identity{}, fmt.Errorf("id claim is missing")
+	}
+	id, err := uuid.Parse(idClaim)
+	if err != nil {
+		return model.Identity{}, fmt.Errorf("failed to parse id claim %w", err)
+	}
+	admin, _ := claims["admin"].(bool)
+	return model.Identity{UserID: id, Admin: admin}, nil
+}
+
 // tokenAdminCheck checks status of admin field in tokens payload
 func tokenAdminCheck(token *jwt.Token) bool {
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
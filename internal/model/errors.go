@@ -0,0 +1,104 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnauthenticated is returned when the caller identity is missing in the context.
+var ErrUnauthenticated = errors.New("caller is not authenticated")
+
+// ErrPermissionDenied is returned when the caller tries to access a car owned by another user.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// ErrInvalidPageToken is returned when a page token can't be decoded or doesn't match the requested order.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// ErrInvalidLabelSelector is returned when a label selector can't be parsed.
+var ErrInvalidLabelSelector = errors.New("invalid label selector")
+
+// ErrUnknownCarField is returned when a car field is not known or may not be changed by a client.
+var ErrUnknownCarField = errors.New("unknown car field")
+
+// ErrInvalidCar is returned when a car breaks the rules of its fields, e.g. after the fields of an update are merged into it.
+var ErrInvalidCar = errors.New("car is not valid")
+
+// ErrDuplicateVIN is returned when another car already has the VIN.
+var ErrDuplicateVIN = errors.New("car with this VIN already exists")
+
+// ErrCarImageNotFound is returned when the car has no image with the ID.
+var ErrCarImageNotFound = errors.New("car image not found")
+
+// ErrUnsupportedImageType is returned when an uploaded file is not an image of a supported type.
+var ErrUnsupportedImageType = errors.New("unsupported image type")
+
+// ErrCarImageTooLarge is returned when an uploaded image is larger than allowed.
+var ErrCarImageTooLarge = errors.New("car image is too large")
+
+// ErrMaintenanceNotFound is returned when there is no open maintenance record with the ID.
+var ErrMaintenanceNotFound = errors.New("open maintenance record not found")
+
+// ErrCarUnderMaintenance is returned when a car with an open critical maintenance record is set running.
+var ErrCarUnderMaintenance = errors.New("car has open critical maintenance")
+
+// ErrReservationConflict is returned when the car is already reserved for a part of the requested time.
+var ErrReservationConflict = errors.New("car is already reserved for this time")
+
+// ErrReservationNotFound is returned when there is no active reservation with the ID.
+var ErrReservationNotFound = errors.New("active reservation not found")
+
+// ErrIdempotencyKeyReused is returned when an idempotency key comes with a request different from the first one.
+var ErrIdempotencyKeyReused = errors.New("idempotency key is already used with a different request")
+
+// ErrIdempotentRequestInProgress is returned when the first request with an idempotency key hasn't finished yet.
+var ErrIdempotentRequestInProgress = errors.New("request with this idempotency key is in progress")
+
+// ErrCarNotFound is returned when there is no car with the ID which is not deleted.
+var ErrCarNotFound = errors.New("car not found")
+
+// ErrBrandNotFound is returned when there is no brand with the ID in the catalog.
+var ErrBrandNotFound = errors.New("brand not found")
+
+// ErrUnknownBrand is returned when a car has a brand which is neither a name nor an alias of a brand of the catalog.
+var ErrUnknownBrand = errors.New("unknown brand")
+
+// ErrDuplicateBrand is returned when the name or an alias of a brand is already used by another brand.
+var ErrDuplicateBrand = errors.New("brand name or alias is already used")
+
+// ErrBrandInUse is returned when a brand which still has cars is deleted.
+var ErrBrandInUse = errors.New("brand has cars")
+
+// ErrVersionConflict is returned when the car was changed after the client has read it.
+var ErrVersionConflict = errors.New("version conflict")
+
+// VersionConflictError reports the version of the car which is currently stored.
+type VersionConflictError struct {
+	CurrentVersion int64
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("car was changed concurrently, current version is %d", e.CurrentVersion)
+}
+
+// Unwrap makes VersionConflictError match ErrVersionConflict.
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
+
+// ErrBatchTooLarge is returned when a batch has more items than may be applied at once.
+var ErrBatchTooLarge = errors.New("batch is too large")
+
+// BatchItemError reports the item of a batch which couldn't be applied, none of the items of the batch are applied then.
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("batch item %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error of the item.
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxCarLabels is the largest number of labels a car may have.
+const MaxCarLabels = 64
+
+// labelNamePattern matches the name of a label key and a non-empty label value: up to 63 letters, digits, '-' and '_'
+// which begin and end with a letter or a digit. Dots are left out, so a key can be used as a field path in MongoDB.
+var labelNamePattern = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_]{0,61}[A-Za-z0-9])?$`)
+
+// ValidLabelKey reports whether key is a name with an optional prefix separated by a slash, such as "team/region".
+func ValidLabelKey(key string) bool {
+	prefix, name, ok := strings.Cut(key, "/")
+	if !ok {
+		return labelNamePattern.MatchString(key)
+	}
+	return labelNamePattern.MatchString(prefix) && labelNamePattern.MatchString(name)
+}
+
+// ValidLabelValue reports whether value may be the value of a label, a label value may be empty.
+func ValidLabelValue(value string) bool {
+	return value == "" || labelNamePattern.MatchString(value)
+}
+
+// LabelOperator is the way a requirement of a label selector compares the label of a car.
+type LabelOperator string
+
+const (
+	// LabelEquals selects the cars with the label set to the value.
+	LabelEquals LabelOperator = "="
+	// LabelNotEquals selects the cars without the label or with the label set to another value.
+	LabelNotEquals LabelOperator = "!="
+	// LabelIn selects the cars with the label set to one of the values.
+	LabelIn LabelOperator = "in"
+	// LabelNotIn selects the cars without the label or with the label set to none of the values.
+	LabelNotIn LabelOperator = "notin"
+	// LabelExists selects the cars with the label.
+	LabelExists LabelOperator = "exists"
+	// LabelDoesNotExist selects the cars without the label.
+	LabelDoesNotExist LabelOperator = "!"
+)
+
+// LabelRequirement is a condition on one label of a car.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+// LabelSelector selects the cars which meet all of its requirements.
+type LabelSelector []LabelRequirement
+
+// setRequirementPattern matches the set based requirements such as "region in (minsk, brest)".
+var setRequirementPattern = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\(([^()]*)\)$`)
+
+// ParseLabelSelector parses the requirements separated by commas the way Kubernetes does: "key=value", "key==value",
+// "key!=value", "key in (a,b)", "key notin (a,b)", "key" and "!key". An empty selector selects every car.
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	var labelSelector LabelSelector
+	for _, term := range splitLabelSelector(selector) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return nil, fmt.Errorf("%w: empty requirement in %q", ErrInvalidLabelSelector, selector)
+		}
+		requirement, err := parseLabelRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		labelSelector = append(labelSelector, requirement)
+	}
+	return labelSelector, nil
+}
+
+// splitLabelSelector splits the selector by the commas which are not inside the parentheses of a set.
+func splitLabelSelector(selector string) []string {
+	if strings.TrimSpace(selector) == "" {
+		return nil
+	}
+	var terms []string
+	depth, start := 0, 0
+	for i, char := range selector {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, selector[start:])
+}
+
+// parseLabelRequirement parses one requirement of a label selector and checks its key and values.
+func parseLabelRequirement(term string) (LabelRequirement, error) {
+	var requirement LabelRequirement
+	if match := setRequirementPattern.FindStringSubmatch(term); match != nil {
+		requirement.Key, requirement.Operator = match[1], LabelOperator(match[2])
+		for _, value := range strings.Split(match[3], ",") {
+			requirement.Values = append(requirement.Values, strings.TrimSpace(value))
+		}
+	} else if key, ok := strings.CutPrefix(term, "!"); ok && !strings.Contains(key, "=") {
+		requirement.Key, requirement.Operator = strings.TrimSpace(key), LabelDoesNotExist
+	} else if key, value, ok := strings.Cut(term, "!="); ok {
+		requirement.Key, requirement.Operator, requirement.Values = strings.TrimSpace(key), LabelNotEquals, []string{strings.TrimSpace(value)}
+	} else if key, value, ok := strings.Cut(term, "=="); ok {
+		requirement.Key, requirement.Operator, requirement.Values = strings.TrimSpace(key), LabelEquals, []string{strings.TrimSpace(value)}
+	} else if key, value, ok := strings.Cut(term, "="); ok {
+		requirement.Key, requirement.Operator, requirement.Values = strings.TrimSpace(key), LabelEquals, []string{strings.TrimSpace(value)}
+	} else {
+		requirement.Key, requirement.Operator = term, LabelExists
+	}
+	if !ValidLabelKey(requirement.Key) {
+		return LabelRequirement{}, fmt.Errorf("%w: invalid label key %q", ErrInvalidLabelSelector, requirement.Key)
+	}
+	for _, value := range requirement.Values {
+		if !ValidLabelValue(value) {
+			return LabelRequirement{}, fmt.Errorf("%w: invalid value %q of label %q", ErrInvalidLabelSelector, value, requirement.Key)
+		}
+	}
+	return requirement, nil
+}
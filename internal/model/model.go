@@ -2,15 +2,166 @@
 package model
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 )
 
 // Car represents a car entity.
 type Car struct {
-	ID             uuid.UUID `json:"id,omitempty" bson:"_id"`
-	Brand          string    `json:"brand" validate:"required"`
-	ProductionYear int64     `json:"productionyear" validate:"gte=1950,lte=2023"`
-	IsRunning      bool      `json:"isrunning"`
+	ID             uuid.UUID         `json:"id,omitempty" bson:"_id"`
+	Brand          string            `json:"brand" validate:"required"`
+	ProductionYear int64             `json:"productionyear" validate:"productionyear"`
+	IsRunning      bool              `json:"isrunning"`
+	OwnerID        uuid.UUID         `json:"ownerid"`
+	Version        int64             `json:"version"`
+	DeletedAt      *time.Time        `json:"deletedat,omitempty" bson:"deletedat,omitempty"`
+	VIN            string            `json:"vin,omitempty" bson:"vin,omitempty" validate:"omitempty,vin"`
+	Model          string            `json:"model,omitempty" validate:"max=50"`
+	Mileage        int64             `json:"mileage" validate:"gte=0"`
+	Color          string            `json:"color,omitempty" validate:"max=30"`
+	Price          int64             `json:"price" validate:"gte=0"` // in cents
+	Labels         map[string]string `json:"labels,omitempty" bson:"labels,omitempty" validate:"labels"`
+	BrandID        uuid.UUID         `json:"brandid"` // the brand of the catalog Brand is resolved to
+}
+
+// Names of the car fields a client may change, they match the names of the proto Car fields.
+const (
+	CarFieldBrand          = "Brand"
+	CarFieldProductionYear = "ProductionYear"
+	CarFieldIsRunning      = "IsRunning"
+	CarFieldVIN            = "VIN"
+	CarFieldModel          = "Model"
+	CarFieldMileage        = "Mileage"
+	CarFieldColor          = "Color"
+	CarFieldPrice          = "Price"
+	CarFieldLabels         = "Labels"
+)
+
+// UpdatableCarFields returns the names of all car fields a client may change.
+func UpdatableCarFields() []string {
+	return []string{
+		CarFieldBrand, CarFieldProductionYear, CarFieldIsRunning,
+		CarFieldVIN, CarFieldModel, CarFieldMileage, CarFieldColor, CarFieldPrice, CarFieldLabels,
+	}
+}
+
+// IsUpdatableCarField reports whether a client may change the car field with the given name.
+func IsUpdatableCarField(field string) bool {
+	for _, updatable := range UpdatableCarFields() {
+		if field == updatable {
+			return true
+		}
+	}
+	return false
+}
+
+// CarUpdate is a change of the given fields of a car, it is an item of a batch update.
+type CarUpdate struct {
+	Car    *Car
+	Fields []string
+}
+
+// CarWatchFilter selects the changes of which cars are watched, an empty field matches every car.
+// A change matches the brand if the car had the brand before or after the change.
+type CarWatchFilter struct {
+	Brand string
+	CarID uuid.UUID
+}
+
+// Matches reports whether the change of a car is selected by the filter.
+func (f *CarWatchFilter) Matches(change *CarChange) bool {
+	if f.CarID != uuid.Nil && f.CarID != change.CarID {
+		return false
+	}
+	if f.Brand == "" {
+		return true
+	}
+	return (change.Before != nil && change.Before.Brand == f.Brand) || (change.After != nil && change.After.Brand == f.Brand)
+}
+
+// CarStats summarizes the cars which are not deleted.
+type CarStats struct {
+	Total           int64                  `json:"total"`
+	Running         int64                  `json:"running"`
+	Brands          []*BrandCount          `json:"brands"`
+	ProductionYears []*ProductionYearCount `json:"productionyears"`
+}
+
+// RunningShare is the share of the cars which are running, from 0 to 1.
+func (s *CarStats) RunningShare() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Running) / float64(s.Total)
+}
+
+// BrandCount is the number of cars of a brand.
+type BrandCount struct {
+	Brand string `json:"brand" bson:"_id"`
+	Count int64  `json:"count"`
+}
+
+// ProductionYearCount is the number of cars produced in a year.
+type ProductionYearCount struct {
+	Year  int64 `json:"year" bson:"_id"`
+	Count int64 `json:"count"`
+}
+
+// CarImage describes an uploaded image of a car, the images of a car are ordered and the first one is the primary image.
+type CarImage struct {
+	ID          uuid.UUID `json:"id" bson:"_id"`
+	CarID       uuid.UUID `json:"carid"`
+	ContentType string    `json:"contenttype"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"` // hex encoded SHA-256 of the image
+	Position    int64     `json:"position"`
+	CreatedAt   time.Time `json:"createdat"`
+}
+
+// CarImageDir is the directory the images of cars are stored in, relative to the directory of the downloadable images.
+const CarImageDir = "upload"
+
+// carImageExtensions are the file extensions of the content types of the images which may be attached to cars.
+var carImageExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// IsCarImageType reports whether images of the content type may be attached to cars.
+func IsCarImageType(contentType string) bool {
+	_, ok := carImageExtensions[contentType]
+	return ok
+}
+
+// FileName is the name of the file the image is stored in, the file is in CarImageDir.
+func (i *CarImage) FileName() string {
+	return i.ID.String() + carImageExtensions[i.ContentType]
+}
+
+// CarImportStatus is the outcome of an imported row.
+type CarImportStatus int
+
+const (
+	// CarImportPending is the status of a row which is not processed yet.
+	CarImportPending CarImportStatus = iota
+	// CarImported is the status of a row whose car was created.
+	CarImported
+	// CarImportSkipped is the status of a row whose car already exists.
+	CarImportSkipped
+	// CarImportFailed is the status of a row which can't be parsed or whose car is not valid.
+	CarImportFailed
+)
+
+// CarImportRow is a car read from a line of an imported file and the outcome of its import.
+type CarImportRow struct {
+	Line   int64
+	Car    *Car
+	Status CarImportStatus
+	Reason string
 }
 
 // User represents a user entity.
@@ -21,3 +172,185 @@ type User struct {
 	RefreshToken []byte    `json:"refreshtoken"`
 	Admin        bool      `json:"admin"`
 }
+
+// Identity is the authenticated caller taken from the claims of the access token.
+type Identity struct {
+	UserID uuid.UUID
+	Admin  bool
+}
+
+// CarSortField is a field the list of cars can be ordered by.
+type CarSortField int
+
+const (
+	// SortByID orders cars by their ID.
+	SortByID CarSortField = iota
+	// SortByBrand orders cars by brand, then by ID.
+	SortByBrand
+	// SortByProductionYear orders cars by production year, then by ID.
+	SortByProductionYear
+)
+
+// CarFilter describes which cars are listed, in which order and which page of them.
+type CarFilter struct {
+	OwnerID           uuid.UUID
+	Brand             string
+	MinProductionYear int64
+	MaxProductionYear int64
+	IsRunning         *bool
+	LabelSelector     LabelSelector
+	SortBy            CarSortField
+	Descending        bool
+	PageSize          int
+	PageToken         string
+}
+
+// CarChangeAction is the kind of change made to a car.
+type CarChangeAction string
+
+const (
+	// CarCreated is the change that created a car.
+	CarCreated CarChangeAction = "created"
+	// CarUpdated is the change of the fields of a car.
+	CarUpdated CarChangeAction = "updated"
+	// CarDeleted is the change that deleted a car.
+	CarDeleted CarChangeAction = "deleted"
+	// CarRestored is the change that brought back a deleted car.
+	CarRestored CarChangeAction = "restored"
+)
+
+// CarChange is an entry of the history of a car, Before is nil for created cars and After is nil for deleted cars.
+type CarChange struct {
+	ID        uuid.UUID       `json:"id" bson:"_id"`
+	CarID     uuid.UUID       `json:"carid"`
+	ActorID   uuid.UUID       `json:"actorid"`
+	Action    CarChangeAction `json:"action"`
+	ChangedAt time.Time       `json:"changedat"`
+	Before    *Car            `json:"before,omitempty" bson:"before,omitempty"`
+	After     *Car            `json:"after,omitempty" bson:"after,omitempty"`
+}
+
+// CarHistoryFilter describes the history of which car is listed and which page of it, the newest changes come first.
+type CarHistoryFilter struct {
+	CarID     uuid.UUID
+	PageSize  int
+	PageToken string
+}
+
+// MaintenanceKind is the kind of work done on a car.
+type MaintenanceKind string
+
+const (
+	// MaintenanceService is the routine service, the due for service query is based on it.
+	MaintenanceService MaintenanceKind = "service"
+	// MaintenanceRepair is the repair of a fault.
+	MaintenanceRepair MaintenanceKind = "repair"
+	// MaintenanceInspection is the technical inspection.
+	MaintenanceInspection MaintenanceKind = "inspection"
+	// MaintenanceTires is the change or repair of the tires.
+	MaintenanceTires MaintenanceKind = "tires"
+)
+
+// MaintenanceRecord is an entry of the servicing of a car, the record is open until the work is done.
+// A car with an open critical record is not running.
+type MaintenanceRecord struct {
+	ID          uuid.UUID       `json:"id" bson:"_id"`
+	CarID       uuid.UUID       `json:"carid"`
+	Kind        MaintenanceKind `json:"kind" validate:"oneof=service repair inspection tires"`
+	PerformedAt time.Time       `json:"performedat" validate:"required"`
+	Mileage     int64           `json:"mileage" validate:"gte=0"`
+	Cost        int64           `json:"cost" validate:"gte=0"` // in cents
+	Notes       string          `json:"notes" validate:"max=1000"`
+	Critical    bool            `json:"critical"`
+	ClosedAt    *time.Time      `json:"closedat,omitempty" bson:"closedat,omitempty"`
+	// StoppedCar tells that the car was running until this critical record, it runs again when the last open critical record is closed.
+	// The mark is passed to another open critical record of the car when this one is closed first.
+	StoppedCar bool `json:"stoppedcar"`
+}
+
+// ServiceDue is a car which is due for the routine service and the last routine service of the car, if there was any.
+type ServiceDue struct {
+	Car                *Car
+	LastServicedAt     *time.Time
+	LastServiceMileage int64
+}
+
+// ServiceDueFilter describes which cars are due for the routine service.
+// A car is due if it has never been serviced, was last serviced before ServicedBefore
+// or has been driven at least MileageInterval since the last service.
+type ServiceDueFilter struct {
+	OwnerID         uuid.UUID
+	ServicedBefore  time.Time
+	MileageInterval int64
+}
+
+// Reservation is the booking of a car by a user for the time from StartsAt until EndsAt.
+// The active reservations of a car never overlap, a canceled reservation frees its time.
+type Reservation struct {
+	ID         uuid.UUID  `json:"id" bson:"_id"`
+	CarID      uuid.UUID  `json:"carid"`
+	UserID     uuid.UUID  `json:"userid"`
+	StartsAt   time.Time  `json:"startsat" validate:"required"`
+	EndsAt     time.Time  `json:"endsat" validate:"required,gtfield=StartsAt"`
+	CreatedAt  time.Time  `json:"createdat"`
+	CanceledAt *time.Time `json:"canceledat,omitempty" bson:"canceledat,omitempty"`
+}
+
+// ReservationFilter selects the reservations of a car, of a user or of a user for a car, canceled ones are left out unless asked for.
+type ReservationFilter struct {
+	CarID           uuid.UUID
+	UserID          uuid.UUID
+	IncludeCanceled bool
+}
+
+// IdempotentResponse is the stored outcome of the first call with an idempotency key.
+type IdempotentResponse struct {
+	// RequestHash is the hash of the first request, a retry has to come with the same request.
+	RequestHash string `json:"requestHash"`
+	// Response is the marshaled response to the first request, it is empty while the request is in progress.
+	Response []byte `json:"response,omitempty"`
+}
+
+// Brand is a brand of the catalog, cars refer to it by its name or by any of its aliases, such as "VW" for "Volkswagen".
+type Brand struct {
+	ID      uuid.UUID `json:"id" bson:"_id"`
+	Name    string    `json:"name" validate:"required,max=30"`
+	Aliases []string  `json:"aliases" validate:"max=20,dive,required,max=30"`
+}
+
+// BrandKey is the key a brand is found by, names and aliases differing only in case or surrounding spaces are the same.
+func BrandKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Keys returns the distinct keys of the name and of the aliases of the brand.
+func (b *Brand) Keys() []string {
+	keys := []string{BrandKey(b.Name)}
+	seen := map[string]bool{keys[0]: true}
+	for _, alias := range b.Aliases {
+		key := BrandKey(alias)
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// CacheReconciliation is the outcome of comparing the cached cars with the database.
+type CacheReconciliation struct {
+	// Checked is the number of cached cars compared with the database.
+	Checked int64
+	// Repaired is the number of cached cars dropped because they differed from the database or were no longer there.
+	Repaired int64
+}
+
+// BrandNormalization is the outcome of moving the existing cars to the brands of the catalog.
+type BrandNormalization struct {
+	// Updated is the number of cars whose brand was replaced with the brand of the catalog.
+	Updated int64
+	// Created are the names of the brands added to the catalog for the cars with unknown brands.
+	Created []string
+	// Unknown are the brands of the cars which are not in the catalog, the cars are left as they are.
+	Unknown []string
+}
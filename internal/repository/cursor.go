@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+)
+
+// carCursor is the position of the last car of a page, the next page starts right after it.
+type carCursor struct {
+	SortBy         model.CarSortField `json:"s"`
+	Descending     bool               `json:"d"`
+	Brand          string             `json:"b,omitempty"`
+	ProductionYear int64              `json:"y,omitempty"`
+	ID             uuid.UUID          `json:"i"`
+}
+
+// encodeCarCursor returns an opaque page token pointing right after the given car.
+func encodeCarCursor(filter *model.CarFilter, car *model.Car) (string, error) {
+	cursor := carCursor{
+		SortBy:     filter.SortBy,
+		Descending: filter.Descending,
+		ID:         car.ID,
+	}
+	switch filter.SortBy {
+	case model.SortByBrand:
+		cursor.Brand = car.Brand
+	case model.SortByProductionYear:
+		cursor.ProductionYear = car.ProductionYear
+	}
+	cursorJSON, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("encodeCarCursor: error in method json.Marshal(): %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(cursorJSON), nil
+}
+
+// decodeCarCursor parses the page token of the filter, it returns nil if the filter asks for the first page.
+func decodeCarCursor(filter *model.CarFilter) (*carCursor, error) {
+	if filter.PageToken == "" {
+		return nil, nil
+	}
+	cursorJSON, err := base64.RawURLEncoding.DecodeString(filter.PageToken)
+	if err != nil {
+		return nil, fmt.Errorf("decodeCarCursor: %w", model.ErrInvalidPageToken)
+	}
+	var cursor carCursor
+	err = json.Unmarshal(cursorJSON, &cursor)
+	if err != nil {
+		return nil, fmt.Errorf("decodeCarCursor: %w", model.ErrInvalidPageToken)
+	}
+	if cursor.SortBy != filter.SortBy || cursor.Descending != filter.Descending {
+		return nil, fmt.Errorf("decodeCarCursor: page token was issued for another order: %w", model.ErrInvalidPageToken)
+	}
+	return &cursor, nil
+}
+
+// carPage drops the extra car that was read to find out whether there is a next page and returns the token of that page.
+func carPage(filter *model.CarFilter, cars []*model.Car) ([]*model.Car, string, error) {
+	if len(cars) <= filter.PageSize {
+		return cars, "", nil
+	}
+	cars = cars[:filter.PageSize]
+	token, err := encodeCarCursor(filter, cars[len(cars)-1])
+	if err != nil {
+		return nil, "", fmt.Errorf("carPage: %w", err)
+	}
+	return cars, token, nil
+}
+
+// carChangeCursor is the position of the last change of a page of the car history.
+type carChangeCursor struct {
+	ChangedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"i"`
+}
+
+// decodeCarChangeCursor parses the page token of the filter, it returns nil if the filter asks for the first page.
+func decodeCarChangeCursor(filter *model.CarHistoryFilter) (*carChangeCursor, error) {
+	if filter.PageToken == "" {
+		return nil, nil
+	}
+	cursorJSON, err := base64.RawURLEncoding.DecodeString(filter.PageToken)
+	if err != nil {
+		return nil, fmt.Errorf("decodeCarChangeCursor: %w", model.ErrInvalidPageToken)
+	}
+	var cursor carChangeCursor
+	err = json.Unmarshal(cursorJSON, &cursor)
+	if err != nil {
+		return nil, fmt.Errorf("decodeCarChangeCursor: %w", model.ErrInvalidPageToken)
+	}
+	return &cursor, nil
+}
+
+// carChangePage drops the extra change that was read to find out whether there is a next page and returns the token of that page.
+func carChangePage(filter *model.CarHistoryFilter, changes []*model.CarChange) ([]*model.CarChange, string, error) {
+	if len(changes) <= filter.PageSize {
+		return changes, "", nil
+	}
+	changes = changes[:filter.PageSize]
+	last := changes[len(changes)-1]
+	cursorJSON, err := json.Marshal(carChangeCursor{ChangedAt: last.ChangedAt, ID: last.ID})
+	if err != nil {
+		return nil, "", fmt.Errorf("carChangePage: error in method json.Marshal(): %w", err)
+	}
+	return changes, base64.RawURLEncoding.EncodeToString(cursorJSON), nil
+}
@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// degradedSubscribeRetryDelay is how long DegradedEventBus waits before subscribing again after the subscription has failed.
+const degradedSubscribeRetryDelay = time.Second
+
+// carCache is the cache of cars DegradedCarCache wraps.
+type carCache interface {
+	GetCache(ctx context.Context, id uuid.UUID) (*model.Car, error)
+	GetCacheMany(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error)
+	GetCacheByVIN(ctx context.Context, vin string) (*model.Car, error)
+	SetCache(ctx context.Context, car *model.Car) error
+	SetCacheMany(ctx context.Context, cars []*model.Car) error
+	SetCacheNotFound(ctx context.Context, id uuid.UUID) error
+	AcquireCarLoadLease(ctx context.Context, id uuid.UUID) (string, error)
+	ReleaseCarLoadLease(ctx context.Context, id uuid.UUID, token string) error
+	DeleteCache(ctx context.Context, id uuid.UUID) error
+	DeleteCacheMany(ctx context.Context, ids []uuid.UUID) error
+	CachedCarIDs(ctx context.Context, cursor uint64, count int64) ([]uuid.UUID, uint64, error)
+	GetStatsCache(ctx context.Context, ownerID uuid.UUID) (*model.CarStats, error)
+	SetStatsCache(ctx context.Context, ownerID uuid.UUID, stats *model.CarStats, ttl time.Duration) error
+}
+
+// idempotencyStore is the store of idempotency keys DegradedIdempotencyStore wraps.
+type idempotencyStore interface {
+	ReserveIdempotencyKey(ctx context.Context, key, requestHash string, ttl time.Duration) (*model.IdempotentResponse, error)
+	SaveIdempotentResponse(ctx context.Context, key string, response *model.IdempotentResponse, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, key string) error
+}
+
+// carEventBus is the bus of changes of cars DegradedEventBus wraps.
+type carEventBus interface {
+	Publish(ctx context.Context, change *model.CarChange) error
+	Subscribe(ctx context.Context, send func(change *model.CarChange) error) error
+}
+
+// failureCounter counts the failures a degraded wrapper hides from its callers.
+type failureCounter struct {
+	// wrapper is the name of the wrapper the logged methods belong to.
+	wrapper  string
+	failures int64
+}
+
+// Failures returns how many calls to the wrapped cache have failed since the start.
+func (f *failureCounter) Failures() int64 {
+	return atomic.LoadInt64(&f.failures)
+}
+
+// fail counts and logs the failure of the cache method.
+func (f *failureCounter) fail(method string, err error) {
+	failures := atomic.AddInt64(&f.failures, 1)
+	logrus.WithFields(logrus.Fields{
+		"method":   f.wrapper + "-" + method,
+		"failures": failures,
+	}).Errorf("cache is unavailable: %v", err)
+}
+
+// DegradedCarCache keeps the calls going while the cache is down: a failed read is a miss and a failed write is dropped,
+// so the cars are read from the database. The failures are logged and counted instead of being returned.
+type DegradedCarCache struct {
+	failureCounter
+	cache carCache
+}
+
+// NewDegradedCarCache wraps the cache, so its failures never fail the calls of the service.
+func NewDegradedCarCache(cache carCache) *DegradedCarCache {
+	return &DegradedCarCache{failureCounter: failureCounter{wrapper: "DegradedCarCache"}, cache: cache}
+}
+
+// isCacheFailure tells a failure of the cache from a miss and from a car cached as not found.
+func isCacheFailure(err error) bool {
+	return err != nil && err != redis.Nil && !errors.Is(err, model.ErrCarNotFound)
+}
+
+// GetCache retrieves the car from the cache, a failure is a miss.
+func (d *DegradedCarCache) GetCache(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	car, err := d.cache.GetCache(ctx, id)
+	if isCacheFailure(err) {
+		d.fail("GetCache", err)
+		return nil, redis.Nil
+	}
+	return car, err
+}
+
+// GetCacheMany retrieves the cars from the cache, a failure misses every car.
+func (d *DegradedCarCache) GetCacheMany(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error) {
+	cars, err := d.cache.GetCacheMany(ctx, ids)
+	if err != nil {
+		d.fail("GetCacheMany", err)
+		return make(map[uuid.UUID]*model.Car), nil
+	}
+	return cars, nil
+}
+
+// GetCacheByVIN retrieves the car with the VIN from the cache, a failure is a miss.
+func (d *DegradedCarCache) GetCacheByVIN(ctx context.Context, vin string) (*model.Car, error) {
+	car, err := d.cache.GetCacheByVIN(ctx, vin)
+	if isCacheFailure(err) {
+		d.fail("GetCacheByVIN", err)
+		return nil, redis.Nil
+	}
+	return car, err
+}
+
+// SetCache stores the car in the cache, a failure is dropped.
+func (d *DegradedCarCache) SetCache(ctx context.Context, car *model.Car) error {
+	err := d.cache.SetCache(ctx, car)
+	if err != nil {
+		d.fail("SetCache", err)
+	}
+	return nil
+}
+
+// SetCacheMany stores the cars in the cache, a failure is dropped.
+func (d *DegradedCarCache) SetCacheMany(ctx context.Context, cars []*model.Car) error {
+	err := d.cache.SetCacheMany(ctx, cars)
+	if err != nil {
+		d.fail("SetCacheMany", err)
+	}
+	return nil
+}
+
+// SetCacheNotFound remembers that there is no car with the ID, a failure is dropped.
+func (d *DegradedCarCache) SetCacheNotFound(ctx context.Context, id uuid.UUID) error {
+	err := d.cache.SetCacheNotFound(ctx, id)
+	if err != nil {
+		d.fail("SetCacheNotFound", err)
+	}
+	return nil
+}
+
+// AcquireCarLoadLease takes the lease to load the missed car. A failure is still returned after it is counted,
+// the service reads the car from the database without the lease then.
+func (d *DegradedCarCache) AcquireCarLoadLease(ctx context.Context, id uuid.UUID) (string, error) {
+	token, err := d.cache.AcquireCarLoadLease(ctx, id)
+	if err != nil {
+		d.fail("AcquireCarLoadLease", err)
+	}
+	return token, err
+}
+
+// ReleaseCarLoadLease gives back the lease, a failure is dropped and the lease expires by itself.
+func (d *DegradedCarCache) ReleaseCarLoadLease(ctx context.Context, id uuid.UUID, token string) error {
+	err := d.cache.ReleaseCarLoadLease(ctx, id, token)
+	if err != nil {
+		d.fail("ReleaseCarLoadLease", err)
+	}
+	return nil
+}
+
+// DeleteCache removes the car from the cache, a failure is dropped and the stale car expires with its TTL.
+func (d *DegradedCarCache) DeleteCache(ctx context.Context, id uuid.UUID) error {
+	err := d.cache.DeleteCache(ctx, id)
+	if err != nil {
+		d.fail("DeleteCache", err)
+	}
+	return nil
+}
+
+// DeleteCacheMany removes the cars from the cache, a failure is dropped and the stale cars expire with their TTL.
+func (d *DegradedCarCache) DeleteCacheMany(ctx context.Context, ids []uuid.UUID) error {
+	err := d.cache.DeleteCacheMany(ctx, ids)
+	if err != nil {
+		d.fail("DeleteCacheMany", err)
+	}
+	return nil
+}
+
+// CachedCarIDs returns the IDs of a part of the cached cars. A failure is still returned after it is counted,
+// nothing but the reconciliation of the cache scans it.
+func (d *DegradedCarCache) CachedCarIDs(ctx context.Context, cursor uint64, count int64) ([]uuid.UUID, uint64, error) {
+	ids, next, err := d.cache.CachedCarIDs(ctx, cursor, count)
+	if err != nil {
+		d.fail("CachedCarIDs", err)
+	}
+	return ids, next, err
+}
+
+// GetStatsCache retrieves the statistics of the cars of the owner from the cache, a failure is a miss.
+func (d *DegradedCarCache) GetStatsCache(ctx context.Context, ownerID uuid.UUID) (*model.CarStats, error) {
+	stats, err := d.cache.GetStatsCache(ctx, ownerID)
+	if err != nil && err != redis.Nil {
+		d.fail("GetStatsCache", err)
+		return nil, redis.Nil
+	}
+	return stats, err
+}
+
+// SetStatsCache stores the statistics of the cars of the owner in the cache, a failure is dropped.
+func (d *DegradedCarCache) SetStatsCache(ctx context.Context, ownerID uuid.UUID, stats *model.CarStats, ttl time.Duration) error {
+	err := d.cache.SetStatsCache(ctx, ownerID, stats, ttl)
+	if err != nil {
+		d.fail("SetStatsCache", err)
+	}
+	return nil
+}
+
+// DegradedIdempotencyStore keeps the mutating calls going while the store is down, they run without idempotency then:
+// a key which can't be reserved is treated as a new one and failed writes are dropped. The failures are logged and counted.
+type DegradedIdempotencyStore struct {
+	failureCounter
+	store idempotencyStore
+}
+
+// NewDegradedIdempotencyStore wraps the store, so its failures never fail the calls with idempotency keys.
+func NewDegradedIdempotencyStore(store idempotencyStore) *DegradedIdempotencyStore {
+	return &DegradedIdempotencyStore{failureCounter: failureCounter{wrapper: "DegradedIdempotencyStore"}, store: store}
+}
+
+// ReserveIdempotencyKey reserves the key or returns the response stored for it, a failure lets the call run as a new one.
+func (d *DegradedIdempotencyStore) ReserveIdempotencyKey(ctx context.Context, key, requestHash string,
+	ttl time.Duration) (*model.IdempotentResponse, error) {
+	stored, err := d.store.ReserveIdempotencyKey(ctx, key, requestHash, ttl)
+	if err != nil {
+		d.fail("ReserveIdempotencyKey", err)
+		return nil, nil
+	}
+	return stored, nil
+}
+
+// SaveIdempotentResponse stores the response to the call with the key, a failure is dropped and the retries run again.
+func (d *DegradedIdempotencyStore) SaveIdempotentResponse(ctx context.Context, key string, response *model.IdempotentResponse,
+	ttl time.Duration) error {
+	err := d.store.SaveIdempotentResponse(ctx, key, response, ttl)
+	if err != nil {
+		d.fail("SaveIdempotentResponse", err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey gives back the key, a failure is dropped and the lease of the key expires by itself.
+func (d *DegradedIdempotencyStore) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	err := d.store.ReleaseIdempotencyKey(ctx, key)
+	if err != nil {
+		d.fail("ReleaseIdempotencyKey", err)
+	}
+	return nil
+}
+
+// DegradedEventBus keeps the writes and the watchers going while the bus is down: a change which can't be published
+// is dropped and a failed subscription is set up again, the changes published meanwhile are missed by the watchers.
+// The failures are logged and counted.
+type DegradedEventBus struct {
+	failureCounter
+	events carEventBus
+}
+
+// NewDegradedEventBus wraps the bus, so its failures never fail the writes of cars nor end the watches.
+func NewDegradedEventBus(events carEventBus) *DegradedEventBus {
+	return &DegradedEventBus{failureCounter: failureCounter{wrapper: "DegradedEventBus"}, events: events}
+}
+
+// Publish passes the change to the watchers, a failure is dropped.
+func (d *DegradedEventBus) Publish(ctx context.Context, change *model.CarChange) error {
+	err := d.events.Publish(ctx, change)
+	if err != nil {
+		d.fail("Publish", err)
+	}
+	return nil
+}
+
+// Subscribe passes every published change of a car to send until ctx is done or send fails,
+// the subscription is set up again after degradedSubscribeRetryDelay whenever the bus fails it.
+func (d *DegradedEventBus) Subscribe(ctx context.Context, send func(change *model.CarChange) error) error {
+	var errSend error
+	for {
+		err := d.events.Subscribe(ctx, func(change *model.CarChange) error {
+			errSend = send(change)
+			return errSend
+		})
+		if errSend != nil || ctx.Err() != nil {
+			return err
+		}
+		d.fail("Subscribe", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(degradedSubscribeRetryDelay):
+		}
+	}
+}
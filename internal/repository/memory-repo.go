@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const (
+	// memorySweepInterval is how often the expired entries are dropped from the maps of MemoryRepository.
+	memorySweepInterval = time.Minute
+	// memoryEventBuffer is how many changes of cars a subscriber of MemoryRepository may fall behind before the next ones are dropped.
+	memoryEventBuffer = 64
+)
+
+// MemoryRepository keeps the cache, the idempotency keys and the changes of cars in the memory of the process instead of Redis,
+// so a single instance can run with only a database, e.g. in development or in CI. Nothing is shared between instances.
+// Like RedisRepository, it returns redis.Nil on a miss.
+type MemoryRepository struct {
+	cfg  *config.Config
+	cars *carLRU
+
+	mu          sync.Mutex
+	sweptAt     time.Time
+	notFound    map[uuid.UUID]time.Time
+	vins        map[string]uuid.UUID
+	stats       map[uuid.UUID]memoryStats
+	idempotency map[string]memoryIdempotentResponse
+	subscribers map[chan *model.CarChange]struct{}
+}
+
+// memoryStats is the cached statistics of the cars of an owner with the time they expire at.
+type memoryStats struct {
+	stats     model.CarStats
+	expiresAt time.Time
+}
+
+// memoryIdempotentResponse is the stored response to the calls with an idempotency key with the time it expires at.
+type memoryIdempotentResponse struct {
+	response  model.IdempotentResponse
+	expiresAt time.Time
+}
+
+// NewMemoryRepository creates a cache of cfg.CarLocalCacheSize cars which expire after cfg.CarCacheTTL.
+func NewMemoryRepository(cfg *config.Config) *MemoryRepository {
+	return &MemoryRepository{
+		cfg:         cfg,
+		cars:        newCarLRU(cfg.CarLocalCacheSize, cfg.CarCacheTTL),
+		sweptAt:     time.Now(),
+		notFound:    make(map[uuid.UUID]time.Time),
+		vins:        make(map[string]uuid.UUID),
+		stats:       make(map[uuid.UUID]memoryStats),
+		idempotency: make(map[string]memoryIdempotentResponse),
+		subscribers: make(map[chan *model.CarChange]struct{}),
+	}
+}
+
+// sweepLocked drops the expired entries once in memorySweepInterval, the entries which are never read again would stay forever otherwise.
+// m.mu must be held.
+func (m *MemoryRepository) sweepLocked() {
+	now := time.Now()
+	if now.Sub(m.sweptAt) < memorySweepInterval {
+		return
+	}
+	m.sweptAt = now
+	for id, expiresAt := range m.notFound {
+		if now.After(expiresAt) {
+			delete(m.notFound, id)
+		}
+	}
+	for vin, id := range m.vins {
+		if car, ok := m.cars.get(id); !ok || car.VIN != vin {
+			delete(m.vins, vin)
+		}
+	}
+	for ownerID, entry := range m.stats {
+		if now.After(entry.expiresAt) {
+			delete(m.stats, ownerID)
+		}
+	}
+	for key, entry := range m.idempotency {
+		if now.After(entry.expiresAt) {
+			delete(m.idempotency, key)
+		}
+	}
+}
+
+// SetCache stores the car in the cache until it expires.
+func (m *MemoryRepository) SetCache(ctx context.Context, car *model.Car) error {
+	return m.SetCacheMany(ctx, []*model.Car{car})
+}
+
+// SetCacheMany stores the cars in the cache, the least recently used cars are evicted when the cache is full.
+// A car is skipped if a newer version of it is already cached.
+func (m *MemoryRepository) SetCacheMany(_ context.Context, cars []*model.Car) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sweepLocked()
+	for _, car := range cars {
+		if !m.cars.put(car) {
+			continue
+		}
+		delete(m.notFound, car.ID)
+		if car.VIN != "" {
+			m.vins[car.VIN] = car.ID
+		}
+	}
+	return nil
+}
+
+// GetCache retrieves the car from the cache, it returns redis.Nil on a miss
+// and model.ErrCarNotFound if the cache remembers that there is no such car.
+func (m *MemoryRepository) GetCache(_ context.Context, id uuid.UUID) (*model.Car, error) {
+	if car, ok := m.cars.get(id); ok {
+		return car, nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if expiresAt, ok := m.notFound[id]; ok && time.Now().Before(expiresAt) {
+		return nil, fmt.Errorf("MemoryRepository-Get: %w", model.ErrCarNotFound)
+	}
+	return nil, redis.Nil
+}
+
+// GetCacheMany retrieves the cars with the IDs from the cache, the cars which are not cached are missing from the result.
+func (m *MemoryRepository) GetCacheMany(_ context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error) {
+	cars := make(map[uuid.UUID]*model.Car, len(ids))
+	for _, id := range ids {
+		if car, ok := m.cars.get(id); ok {
+			cars[id] = car
+		}
+	}
+	return cars, nil
+}
+
+// GetCacheByVIN retrieves the car with the VIN from the cache, it returns redis.Nil on a miss.
+func (m *MemoryRepository) GetCacheByVIN(_ context.Context, vin string) (*model.Car, error) {
+	m.mu.Lock()
+	id, ok := m.vins[vin]
+	m.mu.Unlock()
+	if !ok {
+		return nil, redis.Nil
+	}
+	car, ok := m.cars.get(id)
+	if !ok || car.VIN != vin {
+		return nil, redis.Nil
+	}
+	return car, nil
+}
+
+// SetCacheNotFound remembers for cfg.CarNotFoundCacheTTL that there is no car with the ID, a car stored later replaces the mark.
+func (m *MemoryRepository) SetCacheNotFound(_ context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sweepLocked()
+	// the car may have been created and cached after the database was read
+	if _, ok := m.cars.get(id); ok {
+		return nil
+	}
+	m.notFound[id] = time.Now().Add(m.cfg.CarNotFoundCacheTTL)
+	return nil
+}
+
+// AcquireCarLoadLease always gives the lease, the cache isn't shared and the service already coalesces the misses of the instance.
+func (m *MemoryRepository) AcquireCarLoadLease(_ context.Context, _ uuid.UUID) (string, error) {
+	return uuid.NewString(), nil
+}
+
+// ReleaseCarLoadLease does nothing, see AcquireCarLoadLease.
+func (m *MemoryRepository) ReleaseCarLoadLease(_ context.Context, _ uuid.UUID, _ string) error {
+	return nil
+}
+
+// DeleteCache removes the car with the ID from the cache.
+func (m *MemoryRepository) DeleteCache(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteCacheMany(ctx, []uuid.UUID{id})
+}
+
+// DeleteCacheMany removes the cars with the IDs from the cache, the marks of the IDs which are not found are removed too.
+func (m *MemoryRepository) DeleteCacheMany(_ context.Context, ids []uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cars.remove(ids...)
+	for _, id := range ids {
+		delete(m.notFound, id)
+	}
+	return nil
+}
+
+// CachedCarIDs returns the IDs of all cached cars at once, the returned cursor is always 0.
+func (m *MemoryRepository) CachedCarIDs(_ context.Context, _ uint64, _ int64) ([]uuid.UUID, uint64, error) {
+	return m.cars.ids(), 0, nil
+}
+
+// SetStatsCache stores the statistics of the cars of the owner in the cache for the given time.
+func (m *MemoryRepository) SetStatsCache(_ context.Context, ownerID uuid.UUID, stats *model.CarStats, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sweepLocked()
+	m.stats[ownerID] = memoryStats{stats: *stats, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+// GetStatsCache retrieves the statistics of the cars of the owner from the cache, it returns redis.Nil on a miss.
+func (m *MemoryRepository) GetStatsCache(_ context.Context, ownerID uuid.UUID) (*model.CarStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.stats[ownerID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, redis.Nil
+	}
+	stats := entry.stats
+	return &stats, nil
+}
+
+// ReserveIdempotencyKey stores the hash of the request under the idempotency key for the given time if the key is new,
+// otherwise it returns what is already stored under the key. A nil response means the key is reserved by this call.
+func (m *MemoryRepository) ReserveIdempotencyKey(_ context.Context, key, requestHash string, ttl time.Duration) (*model.IdempotentResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sweepLocked()
+	if entry, ok := m.idempotency[key]; ok && time.Now().Before(entry.expiresAt) {
+		stored := entry.response
+		return &stored, nil
+	}
+	m.idempotency[key] = memoryIdempotentResponse{
+		response:  model.IdempotentResponse{RequestHash: requestHash},
+		expiresAt: time.Now().Add(ttl),
+	}
+	return nil, nil
+}
+
+// SaveIdempotentResponse stores the response to the call which has reserved the idempotency key for the given time.
+func (m *MemoryRepository) SaveIdempotentResponse(_ context.Context, key string, response *model.IdempotentResponse, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.idempotency[key] = memoryIdempotentResponse{response: *response, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+// ReleaseIdempotencyKey removes the idempotency key, so the call can be retried after it has failed.
+func (m *MemoryRepository) ReleaseIdempotencyKey(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.idempotency, key)
+	return nil
+}
+
+// Publish sends the change of a car to every subscriber of this instance,
+// the change is dropped for a subscriber which has fallen memoryEventBuffer changes behind.
+func (m *MemoryRepository) Publish(_ context.Context, change *model.CarChange) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for subscriber := range m.subscribers {
+		changeCopy := *change
+		select {
+		case subscriber <- &changeCopy:
+		default:
+		}
+	}
+	return nil
+}
+
+// Subscribe passes every published change of a car to send until ctx is done or send fails.
+func (m *MemoryRepository) Subscribe(ctx context.Context, send func(change *model.CarChange) error) error {
+	changes := make(chan *model.CarChange, memoryEventBuffer)
+	m.mu.Lock()
+	m.subscribers[changes] = struct{}{}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		delete(m.subscribers, changes)
+		m.mu.Unlock()
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case change := <-changes:
+			err := send(change)
+			if err != nil {
+				return fmt.Errorf("MemoryRepository-Subscribe: error in method send(): %w", err)
+			}
+		}
+	}
+}
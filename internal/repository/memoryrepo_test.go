@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCache(t *testing.T) {
+	memoryRps := NewMemoryRepository(&testConfig)
+	car := model.Car{ID: uuid.New(), Brand: RandBrand(), ProductionYear: RandProductionYear(), VIN: "1HGCM82633A004352"}
+	_, err := memoryRps.GetCache(context.Background(), car.ID)
+	require.Equal(t, redis.Nil, err)
+
+	err = memoryRps.SetCache(context.Background(), &car)
+	require.NoError(t, err)
+	getCar, err := memoryRps.GetCache(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Equal(t, car.Brand, getCar.Brand)
+	getCar, err = memoryRps.GetCacheByVIN(context.Background(), car.VIN)
+	require.NoError(t, err)
+	require.Equal(t, car.ID, getCar.ID)
+	cached, err := memoryRps.GetCacheMany(context.Background(), []uuid.UUID{car.ID, uuid.New()})
+	require.NoError(t, err)
+	require.Len(t, cached, 1)
+	ids, cursor, err := memoryRps.CachedCarIDs(context.Background(), 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{car.ID}, ids)
+	require.Zero(t, cursor)
+
+	// a car created after the database was read isn't replaced by the mark
+	err = memoryRps.SetCacheNotFound(context.Background(), car.ID)
+	require.NoError(t, err)
+	_, err = memoryRps.GetCache(context.Background(), car.ID)
+	require.NoError(t, err)
+
+	err = memoryRps.DeleteCache(context.Background(), car.ID)
+	require.NoError(t, err)
+	err = memoryRps.SetCacheNotFound(context.Background(), car.ID)
+	require.NoError(t, err)
+	_, err = memoryRps.GetCache(context.Background(), car.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+	_, err = memoryRps.GetCacheByVIN(context.Background(), car.VIN)
+	require.Equal(t, redis.Nil, err)
+
+	err = memoryRps.DeleteCache(context.Background(), car.ID)
+	require.NoError(t, err)
+	_, err = memoryRps.GetCache(context.Background(), car.ID)
+	require.Equal(t, redis.Nil, err)
+}
+
+func TestMemoryCacheKeepsNewerCar(t *testing.T) {
+	memoryRps := NewMemoryRepository(&testConfig)
+	newer := model.Car{ID: uuid.New(), Brand: "Newer", ProductionYear: RandProductionYear(), Version: 3}
+	older := newer
+	older.Brand = "Older"
+	older.Version = 2
+	require.NoError(t, memoryRps.SetCache(context.Background(), &newer))
+	require.NoError(t, memoryRps.SetCache(context.Background(), &older))
+	getCar, err := memoryRps.GetCache(context.Background(), newer.ID)
+	require.NoError(t, err)
+	require.Equal(t, newer.Brand, getCar.Brand)
+}
+
+func TestMemoryIdempotencyKey(t *testing.T) {
+	memoryRps := NewMemoryRepository(&testConfig)
+	stored, err := memoryRps.ReserveIdempotencyKey(context.Background(), "key", "hash", time.Minute)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+	stored, err = memoryRps.ReserveIdempotencyKey(context.Background(), "key", "hash", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "hash", stored.RequestHash)
+
+	err = memoryRps.ReleaseIdempotencyKey(context.Background(), "key")
+	require.NoError(t, err)
+	stored, err = memoryRps.ReserveIdempotencyKey(context.Background(), "key", "hash", time.Minute)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+}
+
+func TestMemoryEvents(t *testing.T) {
+	memoryRps := NewMemoryRepository(&testConfig)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	received := make(chan *model.CarChange, 1)
+	go func() {
+		_ = memoryRps.Subscribe(ctx, func(change *model.CarChange) error {
+			received <- change
+			return nil
+		})
+	}()
+	change := &model.CarChange{ID: uuid.New(), CarID: uuid.New(), Action: model.CarCreated}
+	require.Eventually(t, func() bool {
+		_ = memoryRps.Publish(ctx, change)
+		select {
+		case got := <-received:
+			return got.ID == change.ID
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestNoopCarCache(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: RandBrand(), ProductionYear: RandProductionYear()}
+	noop := NewNoopCarCache()
+	err := noop.SetCache(context.Background(), &car)
+	require.NoError(t, err)
+	_, err = noop.GetCache(context.Background(), car.ID)
+	require.Equal(t, redis.Nil, err)
+	token, err := noop.AcquireCarLoadLease(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+}
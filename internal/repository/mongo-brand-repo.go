@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoBrand is the document of a brand, it keeps the keys of the brand for the unique index which resolves names and aliases.
+type mongoBrand struct {
+	model.Brand `bson:",inline"`
+	Keys        []string `bson:"keys"`
+}
+
+// newMongoBrand returns the document of the brand.
+func newMongoBrand(brand *model.Brand) *mongoBrand {
+	return &mongoBrand{Brand: *brand, Keys: brand.Keys()}
+}
+
+// mongoBrandError replaces the duplicate key error of the keys index with model.ErrDuplicateBrand.
+func mongoBrandError(err error) error {
+	if mongo.IsDuplicateKeyError(err) {
+		return model.ErrDuplicateBrand
+	}
+	return err
+}
+
+// CreateBrand inserts a brand into the MongoDB collection, model.ErrDuplicateBrand is returned if another brand has one of its keys.
+func (m *MongoRepository) CreateBrand(ctx context.Context, brand *model.Brand) error {
+	collection := m.client.Database("mdb").Collection("brand")
+	_, err := collection.InsertOne(ctx, newMongoBrand(brand))
+	if err != nil {
+		return fmt.Errorf("MongoRepository-CreateBrand: error in method collection.InsertOne(): %w", mongoBrandError(err))
+	}
+	return nil
+}
+
+// GetBrand retrieves a brand of the catalog by its ID.
+func (m *MongoRepository) GetBrand(ctx context.Context, id uuid.UUID) (*model.Brand, error) {
+	collection := m.client.Database("mdb").Collection("brand")
+	var brand mongoBrand
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&brand)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("MongoRepository-GetBrand: %w", model.ErrBrandNotFound)
+		}
+		return nil, fmt.Errorf("MongoRepository-GetBrand: error in method collection.FindOne(): %w", err)
+	}
+	return &brand.Brand, nil
+}
+
+// ListBrands retrieves all brands of the catalog ordered by name.
+func (m *MongoRepository) ListBrands(ctx context.Context) ([]*model.Brand, error) {
+	collection := m.client.Database("mdb").Collection("brand")
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-ListBrands: error in method collection.Find(): %w", err)
+	}
+	var docs []*mongoBrand
+	err = cursor.All(ctx, &docs)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-ListBrands: error in method cursor.All(): %w", err)
+	}
+	brands := make([]*model.Brand, 0, len(docs))
+	for _, doc := range docs {
+		brands = append(brands, &doc.Brand)
+	}
+	return brands, nil
+}
+
+// UpdateBrand replaces the name and the aliases of a brand, the cars of a renamed brand get the new name and a new version.
+// It returns the IDs of the renamed cars. MongoDB has to run as a replica set for the transaction.
+func (m *MongoRepository) UpdateBrand(ctx context.Context, brand *model.Brand) ([]uuid.UUID, error) {
+	brands := m.client.Database("mdb").Collection("brand")
+	cars := m.client.Database("mdb").Collection("car")
+	var renamed []uuid.UUID
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		res, err := brands.ReplaceOne(sessCtx, bson.M{"_id": brand.ID}, newMongoBrand(brand))
+		if err != nil {
+			return fmt.Errorf("error in method brands.ReplaceOne(): %w", mongoBrandError(err))
+		}
+		if res.MatchedCount == 0 {
+			return model.ErrBrandNotFound
+		}
+		renamed, err = setCarsBrand(sessCtx, cars, bson.M{"brandid": brand.ID, "brand": bson.M{"$ne": brand.Name}}, brand)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-UpdateBrand: %w", err)
+	}
+	return renamed, nil
+}
+
+// DeleteBrand removes a brand from the catalog, model.ErrBrandInUse is returned if the brand still has cars.
+// MongoDB has to run as a replica set for the transaction.
+func (m *MongoRepository) DeleteBrand(ctx context.Context, id uuid.UUID) error {
+	brands := m.client.Database("mdb").Collection("brand")
+	cars := m.client.Database("mdb").Collection("car")
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		inUse, err := cars.CountDocuments(sessCtx, bson.M{"brandid": id}, options.Count().SetLimit(1))
+		if err != nil {
+			return fmt.Errorf("error in method cars.CountDocuments(): %w", err)
+		}
+		if inUse > 0 {
+			return model.ErrBrandInUse
+		}
+		res, err := brands.DeleteOne(sessCtx, bson.M{"_id": id})
+		if err != nil {
+			return fmt.Errorf("error in method brands.DeleteOne(): %w", err)
+		}
+		if res.DeletedCount == 0 {
+			return model.ErrBrandNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-DeleteBrand: %w", err)
+	}
+	return nil
+}
+
+// ResolveBrand finds the brand whose name or alias has the same key as name, model.ErrUnknownBrand is returned if there is none.
+func (m *MongoRepository) ResolveBrand(ctx context.Context, name string) (*model.Brand, error) {
+	collection := m.client.Database("mdb").Collection("brand")
+	var brand mongoBrand
+	err := collection.FindOne(ctx, bson.M{"keys": model.BrandKey(name)}).Decode(&brand)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("MongoRepository-ResolveBrand: %w: %s", model.ErrUnknownBrand, name)
+		}
+		return nil, fmt.Errorf("MongoRepository-ResolveBrand: error in method collection.FindOne(): %w", err)
+	}
+	return &brand.Brand, nil
+}
+
+// CarBrands retrieves the distinct brands of all car records, deleted records included.
+func (m *MongoRepository) CarBrands(ctx context.Context) ([]string, error) {
+	collection := m.client.Database("mdb").Collection("car")
+	values, err := collection.Distinct(ctx, "brand", bson.M{"brand": bson.M{"$type": "string"}})
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-CarBrands: error in method collection.Distinct(): %w", err)
+	}
+	brands := make([]string, 0, len(values))
+	for _, value := range values {
+		brands = append(brands, value.(string))
+	}
+	return brands, nil
+}
+
+// SetCarsBrand moves the car records with the brand from to the brand of the catalog, deleted records included,
+// the moved cars get a new version. It returns the IDs of the moved cars.
+func (m *MongoRepository) SetCarsBrand(ctx context.Context, from string, brand *model.Brand) ([]uuid.UUID, error) {
+	collection := m.client.Database("mdb").Collection("car")
+	filter := bson.M{"brand": from, "$or": bson.A{bson.M{"brand": bson.M{"$ne": brand.Name}}, bson.M{"brandid": bson.M{"$ne": brand.ID}}}}
+	ids, err := setCarsBrand(ctx, collection, filter, brand)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-SetCarsBrand: %w", err)
+	}
+	return ids, nil
+}
+
+// setCarsBrand sets the brand of the cars matching the filter and returns their IDs. The IDs are read first,
+// so the cars which match the filter only after a concurrent change keep their brand until the next run.
+func setCarsBrand(ctx context.Context, cars *mongo.Collection, filter bson.M, brand *model.Brand) ([]uuid.UUID, error) {
+	cursor, err := cars.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("error in method cars.Find(): %w", err)
+	}
+	var docs []struct {
+		ID uuid.UUID `bson:"_id"`
+	}
+	err = cursor.All(ctx, &docs)
+	if err != nil {
+		return nil, fmt.Errorf("error in method cursor.All(): %w", err)
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+	ids := make([]uuid.UUID, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+	_, err = cars.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"brand": brand.Name, "brandid": brand.ID}, "$inc": bson.M{"version": 1}})
+	if err != nil {
+		return nil, fmt.Errorf("error in method cars.UpdateMany(): %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddChange inserts an entry of the history of a car into the MongoDB collection.
+func (m *MongoRepository) AddChange(ctx context.Context, change *model.CarChange) error {
+	collection := m.client.Database("mdb").Collection("carhistory")
+	_, err := collection.InsertOne(ctx, change)
+	if err != nil {
+		return fmt.Errorf("MongoRepository-AddChange: error in method collection.InsertOne(): %w", err)
+	}
+	return nil
+}
+
+// GetHistory retrieves a page of the history of a car, newest changes first, and returns the token of the next page.
+func (m *MongoRepository) GetHistory(ctx context.Context, filter *model.CarHistoryFilter) ([]*model.CarChange, string, error) {
+	cursorPos, err := decodeCarChangeCursor(filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("MongoRepository-GetHistory: %w", err)
+	}
+	collection := m.client.Database("mdb").Collection("carhistory")
+	query := bson.M{"carid": filter.CarID}
+	if cursorPos != nil {
+		query["$or"] = bson.A{
+			bson.M{"changedat": bson.M{"$lt": cursorPos.ChangedAt}},
+			bson.M{"changedat": cursorPos.ChangedAt, "_id": bson.M{"$lt": cursorPos.ID}},
+		}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "changedat", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(filter.PageSize + 1))
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, "", fmt.Errorf("MongoRepository-GetHistory: error in method collection.Find(): %w", err)
+	}
+	var changes []*model.CarChange
+	err = cursor.All(ctx, &changes)
+	if err != nil {
+		return nil, "", fmt.Errorf("MongoRepository-GetHistory: error in method cursor.All(): %w", err)
+	}
+	return carChangePage(filter, changes)
+}
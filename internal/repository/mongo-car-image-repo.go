@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddImage inserts the metadata of an image of a car into the MongoDB collection, the image is placed after the other images of the car.
+// The position is unique for the car, it is taken again if a concurrent upload has taken it first.
+func (m *MongoRepository) AddImage(ctx context.Context, image *model.CarImage) error {
+	collection := m.client.Database("mdb").Collection("carimage")
+	var err error
+	for attempt := 0; attempt < imagePositionAttempts; attempt++ {
+		var last model.CarImage
+		err = collection.FindOne(ctx, bson.M{"carid": image.CarID}, options.FindOne().SetSort(bson.M{"position": -1})).Decode(&last)
+		switch {
+		case err == nil:
+			image.Position = last.Position + 1
+		case errors.Is(err, mongo.ErrNoDocuments):
+			image.Position = 0
+		default:
+			return fmt.Errorf("MongoRepository-AddImage: error in method collection.FindOne(): %w", err)
+		}
+		_, err = collection.InsertOne(ctx, image)
+		if !mongo.IsDuplicateKeyError(err) {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("MongoRepository-AddImage: error in method collection.InsertOne(): %w", err)
+	}
+	return nil
+}
+
+// GetImages retrieves the metadata of the images of a car in their order.
+func (m *MongoRepository) GetImages(ctx context.Context, carID uuid.UUID) ([]*model.CarImage, error) {
+	collection := m.client.Database("mdb").Collection("carimage")
+	opts := options.Find().SetSort(bson.D{{Key: "position", Value: 1}, {Key: "createdat", Value: 1}})
+	cursor, err := collection.Find(ctx, bson.M{"carid": carID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-GetImages: error in method collection.Find(): %w", err)
+	}
+	var images []*model.CarImage
+	err = cursor.All(ctx, &images)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-GetImages: error in method cursor.All(): %w", err)
+	}
+	return images, nil
+}
+
+// SetPrimaryImage moves the image to the first position, the images which were before it move one position down.
+// The images are reordered in a transaction, so MongoDB has to run as a replica set. The unique index of the positions is checked
+// on every write, so the image is put aside first and the images before it are moved from the last one.
+func (m *MongoRepository) SetPrimaryImage(ctx context.Context, carID, imageID uuid.UUID) error {
+	collection := m.client.Database("mdb").Collection("carimage")
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		var image model.CarImage
+		err := collection.FindOneAndUpdate(sessCtx, bson.M{"_id": imageID, "carid": carID},
+			bson.M{"$set": bson.M{"position": -1}}).Decode(&image)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return model.ErrCarImageNotFound
+			}
+			return fmt.Errorf("error in method collection.FindOneAndUpdate(): %w", err)
+		}
+		cursor, err := collection.Find(sessCtx, bson.M{"carid": carID, "position": bson.M{"$gte": 0, "$lt": image.Position}},
+			options.Find().SetSort(bson.M{"position": -1}))
+		if err != nil {
+			return fmt.Errorf("error in method collection.Find(): %w", err)
+		}
+		var before []*model.CarImage
+		err = cursor.All(sessCtx, &before)
+		if err != nil {
+			return fmt.Errorf("error in method cursor.All(): %w", err)
+		}
+		for _, other := range before {
+			_, err = collection.UpdateOne(sessCtx, bson.M{"_id": other.ID}, bson.M{"$inc": bson.M{"position": 1}})
+			if err != nil {
+				return fmt.Errorf("error in method collection.UpdateOne(): %w", err)
+			}
+		}
+		_, err = collection.UpdateOne(sessCtx, bson.M{"_id": imageID}, bson.M{"$set": bson.M{"position": 0}})
+		if err != nil {
+			return fmt.Errorf("error in method collection.UpdateOne(): %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-SetPrimaryImage: %w", err)
+	}
+	return nil
+}
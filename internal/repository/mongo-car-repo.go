@@ -4,11 +4,14 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/distuurbia/firstTaskArtyom/internal/model"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // MongoRepository represents the MongoDB repository.
@@ -28,84 +31,575 @@ func (m *MongoRepository) Create(ctx context.Context, car *model.Car) error {
 	collection := m.client.Database("mdb").Collection("car")
 	_, err := collection.InsertOne(ctx, car)
 	if err != nil {
-		return fmt.Errorf("MongoRepository-Create: error in method collection.InsertOne(): %w", err)
+		return fmt.Errorf("MongoRepository-Create: error in method collection.InsertOne(): %w", mongoWriteError(err))
 	}
 	return nil
 }
 
-// Get retrieves a car record from the MongoDB collection by ID.
+// Get retrieves a car record which is not deleted from the MongoDB collection by ID.
 func (m *MongoRepository) Get(ctx context.Context, id uuid.UUID) (*model.Car, error) {
 	collection := m.client.Database("mdb").Collection("car")
-	filter := bson.M{"_id": id}
+	filter := bson.M{"_id": id, "deletedat": nil}
 	var car model.Car
 	err := collection.FindOne(ctx, filter).Decode(&car)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("MongoRepository-Get: car not found")
+			return nil, fmt.Errorf("MongoRepository-Get: %w", model.ErrCarNotFound)
 		}
 		return nil, fmt.Errorf("MongoRepository-Get: error in method collection.FindOne(): %w", err)
 	}
 	return &car, nil
 }
 
-// Delete removes a car record from the MongoDB collection by ID.
-func (m *MongoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+// GetByVIN retrieves a car record which is not deleted from the MongoDB collection by its VIN.
+func (m *MongoRepository) GetByVIN(ctx context.Context, vin string) (*model.Car, error) {
 	collection := m.client.Database("mdb").Collection("car")
-	filter := bson.M{"_id": id}
-	res, err := collection.DeleteOne(ctx, filter)
+	var car model.Car
+	err := collection.FindOne(ctx, bson.M{"vin": vin, "deletedat": nil}).Decode(&car)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("MongoRepository-GetByVIN: %w", model.ErrCarNotFound)
+		}
+		return nil, fmt.Errorf("MongoRepository-GetByVIN: error in method collection.FindOne(): %w", err)
+	}
+	return &car, nil
+}
+
+// Delete marks a car record as deleted, increments its version and returns the deleted car.
+// The record stays in the MongoDB collection until it is purged.
+func (m *MongoRepository) Delete(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	car, err := m.deleteCar(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-Delete: %w", err)
+	}
+	return car, nil
+}
+
+// deleteCar marks a car record as deleted, increments its version and returns the deleted car.
+// It returns model.ErrCarNotFound if there is no such car which is not deleted.
+func (m *MongoRepository) deleteCar(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	collection := m.client.Database("mdb").Collection("car")
+	filter := bson.M{"_id": id, "deletedat": nil}
+	update := bson.M{"$set": bson.M{"deletedat": time.Now()}, "$inc": bson.M{"version": 1}}
+	var car model.Car
+	err := collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&car)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, model.ErrCarNotFound
+		}
+		return nil, fmt.Errorf("error in method collection.FindOneAndUpdate(): %w", err)
+	}
+	return &car, nil
+}
+
+// Restore clears the deletion mark of a car record and returns the restored car.
+func (m *MongoRepository) Restore(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	collection := m.client.Database("mdb").Collection("car")
+	filter := bson.M{"_id": id, "deletedat": bson.M{"$ne": nil}}
+	update := bson.M{"$unset": bson.M{"deletedat": ""}, "$inc": bson.M{"version": 1}}
+	var car model.Car
+	err := collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&car)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("MongoRepository-Restore: %w", model.ErrCarNotFound)
+		}
+		return nil, fmt.Errorf("MongoRepository-Restore: error in method collection.FindOneAndUpdate(): %w", err)
+	}
+	return &car, nil
+}
+
+// Purge removes the car records which were marked as deleted before the given time and returns their number.
+func (m *MongoRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	collection := m.client.Database("mdb").Collection("car")
+	res, err := collection.DeleteMany(ctx, bson.M{"deletedat": bson.M{"$lt": deletedBefore}})
 	if err != nil {
-		return fmt.Errorf("MongoRepository-Delete: error in method collection.DeleteOne(): %w", err)
+		return 0, fmt.Errorf("MongoRepository-Purge: error in method collection.DeleteMany(): %w", err)
 	}
-	if res.DeletedCount == 0 {
-		return mongo.ErrNoDocuments
+	return res.DeletedCount, nil
+}
+
+// Update updates the given fields of a car record in the MongoDB collection if its stored version equals car.Version and increments the version.
+// car is filled with the updated record, the fields which weren't given are read from the database.
+// The car is updated in a transaction, so a car set running conflicts with a maintenance record added at the same time.
+func (m *MongoRepository) Update(ctx context.Context, car *model.Car, fields []string) error {
+	var updated model.Car
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		updated = *car
+		return m.updateCar(sessCtx, &updated, fields)
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-Update: %w", err)
 	}
+	*car = updated
 	return nil
 }
 
-// Update updates an existing car record in the MongoDB collection.
-func (m *MongoRepository) Update(ctx context.Context, car *model.Car) error {
+// updateCar updates the given fields of a car record if its stored version equals car.Version and increments the version,
+// car is filled with the updated record. It returns model.ErrCarNotFound if there is no such car which is not deleted.
+func (m *MongoRepository) updateCar(ctx context.Context, car *model.Car, fields []string) error {
+	collection := m.client.Database("mdb").Collection("car")
+	set, unset := bson.M{}, bson.M{}
+	for _, field := range fields {
+		switch field {
+		case model.CarFieldBrand:
+			set["brand"] = car.Brand
+			set["brandid"] = car.BrandID
+		case model.CarFieldProductionYear:
+			set["productionyear"] = car.ProductionYear
+		case model.CarFieldIsRunning:
+			if car.IsRunning {
+				open, err := m.CountOpenCritical(ctx, car.ID)
+				if err != nil {
+					return err
+				}
+				if open > 0 {
+					return model.ErrCarUnderMaintenance
+				}
+			}
+			set["isrunning"] = car.IsRunning
+		case model.CarFieldVIN:
+			// cars without a VIN have no vin key, so that the unique sparse index skips them
+			if car.VIN == "" {
+				unset["vin"] = ""
+			} else {
+				set["vin"] = car.VIN
+			}
+		case model.CarFieldModel:
+			set["model"] = car.Model
+		case model.CarFieldMileage:
+			set["mileage"] = car.Mileage
+		case model.CarFieldColor:
+			set["color"] = car.Color
+		case model.CarFieldPrice:
+			set["price"] = car.Price
+		case model.CarFieldLabels:
+			// cars without labels have no labels key, as they are stored by Create
+			if len(car.Labels) == 0 {
+				unset["labels"] = ""
+			} else {
+				set["labels"] = car.Labels
+			}
+		default:
+			return fmt.Errorf("%w: %s", model.ErrUnknownCarField, field)
+		}
+	}
+	filter := bson.M{"_id": car.ID, "version": car.Version, "deletedat": nil}
+	update := bson.M{"$inc": bson.M{"version": 1}}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	var updated model.Car
+	err := collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updated)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return fmt.Errorf("error in method collection.FindOneAndUpdate(): %w", mongoWriteError(err))
+	}
+	if err == mongo.ErrNoDocuments {
+		var current model.Car
+		err = collection.FindOne(ctx, bson.M{"_id": car.ID, "deletedat": nil}).Decode(&current)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return model.ErrCarNotFound
+			}
+			return fmt.Errorf("error in method collection.FindOne(): %w", err)
+		}
+		return &model.VersionConflictError{CurrentVersion: current.Version}
+	}
+	*car = updated
+	return nil
+}
+
+// BatchCreate inserts all the car records in one transaction, none of them are inserted if one fails.
+// Transactions need MongoDB to run as a replica set.
+func (m *MongoRepository) BatchCreate(ctx context.Context, cars []*model.Car) error {
+	collection := m.client.Database("mdb").Collection("car")
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		for i, car := range cars {
+			_, err := collection.InsertOne(sessCtx, car)
+			if err != nil {
+				return &model.BatchItemError{Index: i, Err: fmt.Errorf("error in method collection.InsertOne(): %w", mongoWriteError(err))}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-BatchCreate: %w", err)
+	}
+	return nil
+}
+
+// BatchUpdate applies all the updates in one transaction, none of them are applied if one fails.
+// The cars of the updates are filled with the updated records only after the transaction is committed.
+func (m *MongoRepository) BatchUpdate(ctx context.Context, updates []*model.CarUpdate) error {
+	updated := make([]model.Car, len(updates))
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		for i, update := range updates {
+			updated[i] = *update.Car
+			err := m.updateCar(sessCtx, &updated[i], update.Fields)
+			if err != nil {
+				return &model.BatchItemError{Index: i, Err: err}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-BatchUpdate: %w", err)
+	}
+	for i, update := range updates {
+		*update.Car = updated[i]
+	}
+	return nil
+}
+
+// BatchDelete marks all the car records as deleted in one transaction, none of them are marked if one fails.
+// The deleted cars are returned in the order of the IDs.
+func (m *MongoRepository) BatchDelete(ctx context.Context, ids []uuid.UUID) ([]*model.Car, error) {
+	deleted := make([]*model.Car, len(ids))
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		for i, id := range ids {
+			car, err := m.deleteCar(sessCtx, id)
+			if err != nil {
+				return &model.BatchItemError{Index: i, Err: err}
+			}
+			deleted[i] = car
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-BatchDelete: %w", err)
+	}
+	return deleted, nil
+}
+
+// GetMany retrieves the car records which are not deleted from the MongoDB collection by their IDs in one query,
+// the IDs without such a record are missing from the result.
+func (m *MongoRepository) GetMany(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error) {
+	collection := m.client.Database("mdb").Collection("car")
+	cursor, err := collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "deletedat": nil})
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-GetMany: error in method collection.Find(): %w", err)
+	}
+	var found []*model.Car
+	err = cursor.All(ctx, &found)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-GetMany: error in method cursor.All(): %w", err)
+	}
+	cars := make(map[uuid.UUID]*model.Car, len(found))
+	for _, car := range found {
+		cars[car.ID] = car
+	}
+	return cars, nil
+}
+
+// ExistingIDs reports which of the IDs belong to car records in the MongoDB collection, deleted records included.
+func (m *MongoRepository) ExistingIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+	collection := m.client.Database("mdb").Collection("car")
+	cursor, err := collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-ExistingIDs: error in method collection.Find(): %w", err)
+	}
+	var found []struct {
+		ID uuid.UUID `bson:"_id"`
+	}
+	err = cursor.All(ctx, &found)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-ExistingIDs: error in method cursor.All(): %w", err)
+	}
+	existing := make(map[uuid.UUID]bool, len(found))
+	for _, car := range found {
+		existing[car.ID] = true
+	}
+	return existing, nil
+}
+
+// Stats counts the cars which are not deleted by brand, by production year and by whether they are running,
+// the cars of every owner are counted if ownerID is uuid.Nil.
+func (m *MongoRepository) Stats(ctx context.Context, ownerID uuid.UUID) (*model.CarStats, error) {
 	collection := m.client.Database("mdb").Collection("car")
-	filter := bson.M{"_id": car.ID}
-	update := bson.M{
-		"$set": bson.M{
-			"brand":          car.Brand,
-			"productionyear": car.ProductionYear,
-			"isrunning":      car.IsRunning,
-		},
+	match := bson.M{"deletedat": nil}
+	if ownerID != uuid.Nil {
+		match["ownerid"] = ownerID
+	}
+	countBy := func(field string) bson.A {
+		return bson.A{
+			bson.M{"$group": bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.M{"_id": 1}},
+		}
+	}
+	pipeline := bson.A{
+		bson.M{"$match": match},
+		bson.M{"$facet": bson.M{
+			"brands":          countBy("brand"),
+			"productionyears": countBy("productionyear"),
+			"running":         countBy("isrunning"),
+		}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-Stats: error in method collection.Aggregate(): %w", err)
+	}
+	var facets []struct {
+		Brands          []*model.BrandCount          `bson:"brands"`
+		ProductionYears []*model.ProductionYearCount `bson:"productionyears"`
+		Running         []struct {
+			IsRunning bool  `bson:"_id"`
+			Count     int64 `bson:"count"`
+		} `bson:"running"`
 	}
-	res, err := collection.UpdateOne(ctx, filter, update)
+	err = cursor.All(ctx, &facets)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-Stats: error in method cursor.All(): %w", err)
+	}
+	stats := &model.CarStats{}
+	if len(facets) == 0 {
+		return stats, nil
+	}
+	stats.Brands = facets[0].Brands
+	stats.ProductionYears = facets[0].ProductionYears
+	for _, running := range facets[0].Running {
+		stats.Total += running.Count
+		if running.IsRunning {
+			stats.Running += running.Count
+		}
+	}
+	return stats, nil
+}
+
+// mongoWriteError replaces the duplicate key error of the VIN index with model.ErrDuplicateVIN.
+func mongoWriteError(err error) error {
+	if mongo.IsDuplicateKeyError(err) && strings.Contains(err.Error(), "vin_1") {
+		return model.ErrDuplicateVIN
+	}
+	return err
+}
+
+// inTx runs fn in a transaction which is committed if fn succeeds and aborted otherwise.
+func (m *MongoRepository) inTx(ctx context.Context, fn func(sessCtx mongo.SessionContext) error) error {
+	session, err := m.client.StartSession()
 	if err != nil {
-		return fmt.Errorf("MongoRepository-Update: error in method collection.UpdateOne(): %w", err)
+		return fmt.Errorf("error in method m.client.StartSession(): %w", err)
 	}
-	if res.MatchedCount == 0 {
-		return mongo.ErrNoDocuments
+	defer session.EndSession(context.Background())
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	if err != nil {
+		return fmt.Errorf("error in method session.WithTransaction(): %w", err)
 	}
 	return nil
 }
 
-// GetAll retrieves all car records from the MongoDB collection.
-func (m *MongoRepository) GetAll(ctx context.Context) ([]*model.Car, error) {
+// GetAll retrieves a page of car records matching the filter and returns the token of the next page.
+func (m *MongoRepository) GetAll(ctx context.Context, filter *model.CarFilter) ([]*model.Car, string, error) {
+	var cars []*model.Car
+	err := m.Stream(ctx, filter, func(car *model.Car) error {
+		cars = append(cars, car)
+		return nil
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("MongoRepository-GetAll: %w", err)
+	}
+	return carPage(filter, cars)
+}
+
+// Stream reads the car records matching the filter and passes each of them to send as soon as it is decoded.
+func (m *MongoRepository) Stream(ctx context.Context, filter *model.CarFilter, send func(car *model.Car) error) error {
+	cursorPos, err := decodeCarCursor(filter)
+	if err != nil {
+		return fmt.Errorf("MongoRepository-Stream: %w", err)
+	}
 	collection := m.client.Database("mdb").Collection("car")
-	cursor, err := collection.Find(ctx, bson.M{})
+	query, opts := mongoCarQuery(filter, cursorPos)
+	cursor, err := collection.Find(ctx, query, opts)
 	if err != nil {
-		return nil, fmt.Errorf("MongoRepository-GetAll: error in method collection.Find(): %w", err)
+		return fmt.Errorf("MongoRepository-Stream: error in method collection.Find(): %w", err)
 	}
 	defer func() {
-		err := cursor.Close(ctx)
+		err := cursor.Close(context.Background())
 		if err != nil {
-			fmt.Printf("MongoRepository-GetAll: Failed to close cursor: %v", err)
+			fmt.Printf("MongoRepository-Stream: Failed to close cursor: %v", err)
 		}
 	}()
-	var cars []*model.Car
 	for cursor.Next(ctx) {
 		var car model.Car
 		if err := cursor.Decode(&car); err != nil {
-			return nil, fmt.Errorf("MongoRepository-GetAll: error decoding car: %w", err)
+			return fmt.Errorf("MongoRepository-Stream: error decoding car: %w", err)
+		}
+		err = send(&car)
+		if err != nil {
+			return fmt.Errorf("MongoRepository-Stream: error in method send(): %w", err)
 		}
-		cars = append(cars, &car)
 	}
 	if err := cursor.Err(); err != nil {
-		return nil, fmt.Errorf("MongoRepository-GetAll: error in cursor: %w", err)
+		return fmt.Errorf("MongoRepository-Stream: error in cursor: %w", err)
 	}
-	return cars, nil
+	return nil
+}
+
+// mongoCarQuery builds the query and the sort and limit options that select cars by the filter, the page size of 0 means no limit.
+func mongoCarQuery(filter *model.CarFilter, cursorPos *carCursor) (bson.M, *options.FindOptions) {
+	query := bson.M{"deletedat": nil}
+	if filter.OwnerID != uuid.Nil {
+		query["ownerid"] = filter.OwnerID
+	}
+	if filter.Brand != "" {
+		query["brand"] = filter.Brand
+	}
+	productionYear := bson.M{}
+	if filter.MinProductionYear != 0 {
+		productionYear["$gte"] = filter.MinProductionYear
+	}
+	if filter.MaxProductionYear != 0 {
+		productionYear["$lte"] = filter.MaxProductionYear
+	}
+	if len(productionYear) > 0 {
+		query["productionyear"] = productionYear
+	}
+	if filter.IsRunning != nil {
+		query["isrunning"] = *filter.IsRunning
+	}
+	if len(filter.LabelSelector) > 0 {
+		// a key may have several requirements, so each of them is a separate condition
+		labels := make(bson.A, 0, len(filter.LabelSelector))
+		for _, requirement := range filter.LabelSelector {
+			labels = append(labels, mongoLabelCondition(requirement))
+		}
+		query["$and"] = labels
+	}
+	order, comparison := 1, "$gt"
+	if filter.Descending {
+		order, comparison = -1, "$lt"
+	}
+	sort := bson.D{{Key: "_id", Value: order}}
+	sortField := ""
+	var sortValue interface{}
+	switch filter.SortBy {
+	case model.SortByBrand:
+		sortField = "brand"
+		if cursorPos != nil {
+			sortValue = cursorPos.Brand
+		}
+	case model.SortByProductionYear:
+		sortField = "productionyear"
+		if cursorPos != nil {
+			sortValue = cursorPos.ProductionYear
+		}
+	}
+	if sortField != "" {
+		sort = bson.D{{Key: sortField, Value: order}, {Key: "_id", Value: order}}
+	}
+	if cursorPos != nil {
+		after := bson.M{"_id": bson.M{comparison: cursorPos.ID}}
+		if sortField != "" {
+			after = bson.M{"$or": bson.A{
+				bson.M{sortField: bson.M{comparison: sortValue}},
+				bson.M{sortField: sortValue, "_id": bson.M{comparison: cursorPos.ID}},
+			}}
+		}
+		query = bson.M{"$and": bson.A{query, after}}
+	}
+	opts := options.Find().SetSort(sort)
+	if filter.PageSize > 0 {
+		opts.SetLimit(int64(filter.PageSize + 1))
+	}
+	return query, opts
+}
+
+// mongoLabelCondition builds the condition of a label requirement on the field of the label in the labels sub-document,
+// the label keys have no dots, so they can't address nested fields.
+func mongoLabelCondition(requirement model.LabelRequirement) bson.M {
+	field := "labels." + requirement.Key
+	switch requirement.Operator {
+	case model.LabelEquals:
+		return bson.M{field: requirement.Values[0]}
+	case model.LabelNotEquals:
+		return bson.M{field: bson.M{"$ne": requirement.Values[0]}}
+	case model.LabelIn:
+		return bson.M{field: bson.M{"$in": requirement.Values}}
+	case model.LabelNotIn:
+		return bson.M{field: bson.M{"$nin": requirement.Values}}
+	case model.LabelDoesNotExist:
+		return bson.M{field: bson.M{"$exists": false}}
+	default:
+		return bson.M{field: bson.M{"$exists": true}}
+	}
+}
+
+// EnsureIndexes creates the indexes the car queries rely on.
+func (m *MongoRepository) EnsureIndexes(ctx context.Context) error {
+	collection := m.client.Database("mdb").Collection("car")
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "brand", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "productionyear", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "ownerid", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "deletedat", Value: 1}}, Options: options.Index().SetSparse(true)},
+		{Keys: bson.D{{Key: "vin", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+		{Keys: bson.D{{Key: "labels.$**", Value: 1}}},
+		{Keys: bson.D{{Key: "brandid", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-EnsureIndexes: error in method collection.Indexes().CreateMany(): %w", err)
+	}
+	history := m.client.Database("mdb").Collection("carhistory")
+	_, err = history.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "carid", Value: 1}, {Key: "changedat", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-EnsureIndexes: error in method history.Indexes().CreateOne(): %w", err)
+	}
+	images := m.client.Database("mdb").Collection("carimage")
+	err = dropNonUniqueIndex(ctx, images, "carid_1_position_1")
+	if err != nil {
+		return fmt.Errorf("MongoRepository-EnsureIndexes: %w", err)
+	}
+	_, err = images.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "carid", Value: 1}, {Key: "position", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-EnsureIndexes: error in method images.Indexes().CreateOne(): %w", err)
+	}
+	maintenance := m.client.Database("mdb").Collection("maintenance")
+	_, err = maintenance.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "carid", Value: 1}, {Key: "performedat", Value: -1}},
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-EnsureIndexes: error in method maintenance.Indexes().CreateOne(): %w", err)
+	}
+	reservations := m.client.Database("mdb").Collection("reservation")
+	_, err = reservations.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "carid", Value: 1}, {Key: "startsat", Value: 1}}},
+		{Keys: bson.D{{Key: "userid", Value: 1}, {Key: "startsat", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-EnsureIndexes: error in method reservations.Indexes().CreateMany(): %w", err)
+	}
+	brands := m.client.Database("mdb").Collection("brand")
+	_, err = brands.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "keys", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-EnsureIndexes: error in method brands.Indexes().CreateOne(): %w", err)
+	}
+	return nil
+}
+
+// dropNonUniqueIndex drops the index with the name if it is not unique, so it can be created again as a unique one.
+func dropNonUniqueIndex(ctx context.Context, collection *mongo.Collection, name string) error {
+	specs, err := collection.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return fmt.Errorf("error in method collection.Indexes().ListSpecifications(): %w", err)
+	}
+	for _, spec := range specs {
+		if spec.Name == name && (spec.Unique == nil || !*spec.Unique) {
+			_, err = collection.Indexes().DropOne(ctx, name)
+			if err != nil {
+				return fmt.Errorf("error in method collection.Indexes().DropOne(): %w", err)
+			}
+		}
+	}
+	return nil
 }
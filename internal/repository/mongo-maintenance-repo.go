@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddMaintenance inserts a maintenance record of a car into the MongoDB collection. An open critical record stops the running car
+// in the same transaction, the stopped car is returned then and nil is returned if the car isn't changed.
+// Transactions need MongoDB to run as a replica set.
+func (m *MongoRepository) AddMaintenance(ctx context.Context, record *model.MaintenanceRecord) (*model.Car, error) {
+	collection := m.client.Database("mdb").Collection("maintenance")
+	var stopped *model.Car
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		stopped = nil
+		// the car is always written, so an update setting the car running at the same time conflicts with the record and is retried
+		var car model.Car
+		err := m.client.Database("mdb").Collection("car").FindOneAndUpdate(sessCtx, bson.M{"_id": record.CarID, "deletedat": nil},
+			bson.M{"$inc": bson.M{"maintenancelock": 1}}).Decode(&car)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return model.ErrCarNotFound
+			}
+			return fmt.Errorf("error in method collection.FindOneAndUpdate(): %w", err)
+		}
+		record.StoppedCar = record.Critical && record.ClosedAt == nil && car.IsRunning
+		_, err = collection.InsertOne(sessCtx, record)
+		if err != nil {
+			return fmt.Errorf("error in method collection.InsertOne(): %w", err)
+		}
+		if record.StoppedCar {
+			stopped, err = m.setCarRunning(sessCtx, record.CarID, false)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-AddMaintenance: %w", err)
+	}
+	return stopped, nil
+}
+
+// setCarRunning changes the running state of the car, increments its version and returns the changed car.
+func (m *MongoRepository) setCarRunning(sessCtx mongo.SessionContext, carID uuid.UUID, running bool) (*model.Car, error) {
+	var car model.Car
+	err := m.client.Database("mdb").Collection("car").FindOneAndUpdate(sessCtx, bson.M{"_id": carID},
+		bson.M{"$set": bson.M{"isrunning": running}, "$inc": bson.M{"version": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&car)
+	if err != nil {
+		return nil, fmt.Errorf("error in method collection.FindOneAndUpdate(): %w", err)
+	}
+	return &car, nil
+}
+
+// GetMaintenance retrieves a maintenance record by its ID.
+func (m *MongoRepository) GetMaintenance(ctx context.Context, id uuid.UUID) (*model.MaintenanceRecord, error) {
+	collection := m.client.Database("mdb").Collection("maintenance")
+	var record model.MaintenanceRecord
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&record)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("MongoRepository-GetMaintenance: %w", model.ErrMaintenanceNotFound)
+		}
+		return nil, fmt.Errorf("MongoRepository-GetMaintenance: error in method collection.FindOne(): %w", err)
+	}
+	return &record, nil
+}
+
+// ListMaintenance retrieves the maintenance records of a car, the latest work comes first.
+func (m *MongoRepository) ListMaintenance(ctx context.Context, carID uuid.UUID) ([]*model.MaintenanceRecord, error) {
+	collection := m.client.Database("mdb").Collection("maintenance")
+	opts := options.Find().SetSort(bson.D{{Key: "performedat", Value: -1}, {Key: "_id", Value: 1}})
+	cursor, err := collection.Find(ctx, bson.M{"carid": carID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-ListMaintenance: error in method collection.Find(): %w", err)
+	}
+	var records []*model.MaintenanceRecord
+	err = cursor.All(ctx, &records)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-ListMaintenance: error in method cursor.All(): %w", err)
+	}
+	return records, nil
+}
+
+// CloseMaintenance marks an open maintenance record as done and returns the closed record. If the record holds the mark
+// of stopping the car, the mark is passed to another open critical record of the car or, if there is none, the car runs again
+// in the same transaction. The car which runs again is returned too, nil is returned if the car isn't changed.
+func (m *MongoRepository) CloseMaintenance(ctx context.Context, id uuid.UUID, closedAt time.Time) (*model.MaintenanceRecord, *model.Car, error) {
+	collection := m.client.Database("mdb").Collection("maintenance")
+	var record model.MaintenanceRecord
+	var restarted *model.Car
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		restarted = nil
+		err := collection.FindOneAndUpdate(sessCtx, bson.M{"_id": id, "closedat": nil}, bson.M{"$set": bson.M{"closedat": closedAt}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&record)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return model.ErrMaintenanceNotFound
+			}
+			return fmt.Errorf("error in method collection.FindOneAndUpdate(): %w", err)
+		}
+		if !record.StoppedCar {
+			return nil
+		}
+		var next model.MaintenanceRecord
+		opts := options.FindOneAndUpdate().SetSort(bson.D{{Key: "performedat", Value: 1}, {Key: "_id", Value: 1}})
+		err = collection.FindOneAndUpdate(sessCtx, bson.M{"carid": record.CarID, "critical": true, "closedat": nil},
+			bson.M{"$set": bson.M{"stoppedcar": true}}, opts).Decode(&next)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("error in method collection.FindOneAndUpdate(): %w", err)
+		}
+		count, err := m.client.Database("mdb").Collection("car").CountDocuments(sessCtx, bson.M{"_id": record.CarID, "deletedat": nil})
+		if err != nil {
+			return fmt.Errorf("error in method collection.CountDocuments(): %w", err)
+		}
+		if count > 0 {
+			restarted, err = m.setCarRunning(sessCtx, record.CarID, true)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("MongoRepository-CloseMaintenance: %w", err)
+	}
+	return &record, restarted, nil
+}
+
+// CountOpenCritical counts the open critical maintenance records of a car.
+func (m *MongoRepository) CountOpenCritical(ctx context.Context, carID uuid.UUID) (int64, error) {
+	collection := m.client.Database("mdb").Collection("maintenance")
+	count, err := collection.CountDocuments(ctx, bson.M{"carid": carID, "critical": true, "closedat": nil})
+	if err != nil {
+		return 0, fmt.Errorf("MongoRepository-CountOpenCritical: error in method collection.CountDocuments(): %w", err)
+	}
+	return count, nil
+}
+
+// DueForService retrieves the cars which are not deleted and are due for the routine service by the filter,
+// the cars which were never serviced come first, then the ones serviced longest ago.
+func (m *MongoRepository) DueForService(ctx context.Context, filter *model.ServiceDueFilter) ([]*model.ServiceDue, error) {
+	collection := m.client.Database("mdb").Collection("car")
+	match := bson.M{"deletedat": nil}
+	if filter.OwnerID != uuid.Nil {
+		match["ownerid"] = filter.OwnerID
+	}
+	pipeline := bson.A{
+		bson.M{"$match": match},
+		bson.M{"$lookup": bson.M{
+			"from": "maintenance",
+			"let":  bson.M{"carid": "$_id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{
+					"$expr":    bson.M{"$eq": bson.A{"$carid", "$$carid"}},
+					"kind":     model.MaintenanceService,
+					"closedat": bson.M{"$ne": nil},
+				}},
+				bson.M{"$sort": bson.M{"performedat": -1}},
+				bson.M{"$limit": 1},
+			},
+			"as": "lastservice",
+		}},
+		bson.M{"$set": bson.M{"lastservice": bson.M{"$arrayElemAt": bson.A{"$lastservice", 0}}}},
+		bson.M{"$match": bson.M{"$or": bson.A{
+			bson.M{"lastservice": nil},
+			bson.M{"lastservice.performedat": bson.M{"$lt": filter.ServicedBefore}},
+			bson.M{"$expr": bson.M{"$gte": bson.A{bson.M{"$subtract": bson.A{"$mileage", "$lastservice.mileage"}}, filter.MileageInterval}}},
+		}}},
+		bson.M{"$sort": bson.D{{Key: "lastservice.performedat", Value: 1}, {Key: "_id", Value: 1}}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-DueForService: error in method collection.Aggregate(): %w", err)
+	}
+	var cars []struct {
+		model.Car   `bson:",inline"`
+		LastService *model.MaintenanceRecord `bson:"lastservice"`
+	}
+	err = cursor.All(ctx, &cars)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-DueForService: error in method cursor.All(): %w", err)
+	}
+	due := make([]*model.ServiceDue, 0, len(cars))
+	for i := range cars {
+		item := &model.ServiceDue{Car: &cars[i].Car}
+		if cars[i].LastService != nil {
+			item.LastServicedAt = &cars[i].LastService.PerformedAt
+			item.LastServiceMileage = cars[i].LastService.Mileage
+		}
+		due = append(due, item)
+	}
+	return due, nil
+}
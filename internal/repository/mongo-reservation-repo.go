@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateReservation inserts a reservation into the MongoDB collection if it doesn't overlap an active reservation of the car,
+// model.ErrReservationConflict is returned otherwise. The check and the insert run in a transaction which also bumps
+// the reservation lock of the car, so concurrent reservations of the car conflict and are retried.
+// MongoDB has to run as a replica set for the transaction.
+func (m *MongoRepository) CreateReservation(ctx context.Context, reservation *model.Reservation) error {
+	reservations := m.client.Database("mdb").Collection("reservation")
+	locks := m.client.Database("mdb").Collection("reservationlock")
+	err := m.inTx(ctx, func(sessCtx mongo.SessionContext) error {
+		_, err := locks.UpdateOne(sessCtx, bson.M{"_id": reservation.CarID}, bson.M{"$inc": bson.M{"seq": 1}}, options.Update().SetUpsert(true))
+		if err != nil {
+			return fmt.Errorf("error in method locks.UpdateOne(): %w", err)
+		}
+		overlapping, err := reservations.CountDocuments(sessCtx, bson.M{
+			"carid":      reservation.CarID,
+			"canceledat": nil,
+			"startsat":   bson.M{"$lt": reservation.EndsAt},
+			"endsat":     bson.M{"$gt": reservation.StartsAt},
+		})
+		if err != nil {
+			return fmt.Errorf("error in method reservations.CountDocuments(): %w", err)
+		}
+		if overlapping > 0 {
+			return model.ErrReservationConflict
+		}
+		_, err = reservations.InsertOne(sessCtx, reservation)
+		if err != nil {
+			return fmt.Errorf("error in method reservations.InsertOne(): %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("MongoRepository-CreateReservation: %w", err)
+	}
+	return nil
+}
+
+// GetReservation retrieves a reservation by its ID, canceled reservations included.
+func (m *MongoRepository) GetReservation(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	collection := m.client.Database("mdb").Collection("reservation")
+	var reservation model.Reservation
+	err := collection.FindOne(ctx, bson.M{"_id": id}).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("MongoRepository-GetReservation: %w", model.ErrReservationNotFound)
+		}
+		return nil, fmt.Errorf("MongoRepository-GetReservation: error in method collection.FindOne(): %w", err)
+	}
+	return &reservation, nil
+}
+
+// CancelReservation marks an active reservation as canceled and returns the canceled reservation.
+func (m *MongoRepository) CancelReservation(ctx context.Context, id uuid.UUID, canceledAt time.Time) (*model.Reservation, error) {
+	collection := m.client.Database("mdb").Collection("reservation")
+	var reservation model.Reservation
+	err := collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "canceledat": nil}, bson.M{"$set": bson.M{"canceledat": canceledAt}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("MongoRepository-CancelReservation: %w", model.ErrReservationNotFound)
+		}
+		return nil, fmt.Errorf("MongoRepository-CancelReservation: error in method collection.FindOneAndUpdate(): %w", err)
+	}
+	return &reservation, nil
+}
+
+// ListReservations retrieves the reservations matching the filter in the order of their start.
+func (m *MongoRepository) ListReservations(ctx context.Context, filter *model.ReservationFilter) ([]*model.Reservation, error) {
+	collection := m.client.Database("mdb").Collection("reservation")
+	query := bson.M{}
+	if filter.CarID != uuid.Nil {
+		query["carid"] = filter.CarID
+	}
+	if filter.UserID != uuid.Nil {
+		query["userid"] = filter.UserID
+	}
+	if !filter.IncludeCanceled {
+		query["canceledat"] = nil
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "startsat", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-ListReservations: error in method collection.Find(): %w", err)
+	}
+	var reservations []*model.Reservation
+	err = cursor.All(ctx, &reservations)
+	if err != nil {
+		return nil, fmt.Errorf("MongoRepository-ListReservations: error in method cursor.All(): %w", err)
+	}
+	return reservations, nil
+}
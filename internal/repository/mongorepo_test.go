@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,7 +12,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func TestCreateMongo(t *testing.T) {
@@ -34,7 +34,7 @@ func TestUpdateMongo(t *testing.T) {
 	testModel.ProductionYear = 1999
 	testModel.IsRunning = false
 
-	err := mrpc.Update(context.Background(), &testModel)
+	err := mrpc.Update(context.Background(), &testModel, model.UpdatableCarFields())
 	require.NoError(t, err)
 
 	var updCar *model.Car
@@ -46,6 +46,23 @@ func TestUpdateMongo(t *testing.T) {
 	require.Equal(t, testModel.IsRunning, updCar.IsRunning)
 }
 
+func TestUpdatePartialMongo(t *testing.T) {
+	partialCar := model.Car{ID: testModel.ID, Brand: "NotUpdatedBrand", ProductionYear: testModel.ProductionYear, Version: testModel.Version}
+	err := mrpc.Update(context.Background(), &partialCar, []string{model.CarFieldProductionYear})
+	require.NoError(t, err)
+	testModel.Version = partialCar.Version
+	// the fields which weren't updated are read back from the record
+	require.Equal(t, testModel.Brand, partialCar.Brand)
+	require.Equal(t, testModel.IsRunning, partialCar.IsRunning)
+}
+
+func TestUpdateVersionConflictMongo(t *testing.T) {
+	staleCar := testModel
+	staleCar.Version--
+	err := mrpc.Update(context.Background(), &staleCar, model.UpdatableCarFields())
+	require.ErrorIs(t, err, model.ErrVersionConflict)
+}
+
 func TestGetMongo(t *testing.T) {
 	var getcar *model.Car
 	getcar, err := mrpc.Get(context.Background(), testModel.ID)
@@ -57,25 +74,73 @@ func TestGetMongo(t *testing.T) {
 }
 
 func TestDeleteMongo(t *testing.T) {
-	err := mrpc.Delete(context.Background(), testModel.ID)
+	_, err := mrpc.Delete(context.Background(), testModel.ID)
 	require.NoError(t, err)
 
 	time.Sleep(100 * time.Millisecond)
 
 	_, err = mrpc.Get(context.Background(), testModel.ID)
-	require.Error(t, err)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+}
+
+func TestRestoreAndPurgeMongo(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: "RestoreBrandMongo", ProductionYear: RandProductionYear(), Version: 1}
+	err := mrpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	deleted, err := mrpc.Delete(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.NotNil(t, deleted.DeletedAt)
+	require.Equal(t, car.Version+1, deleted.Version)
+
+	restored, err := mrpc.Restore(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Nil(t, restored.DeletedAt)
+	require.Equal(t, car.Version+2, restored.Version)
+	_, err = mrpc.Restore(context.Background(), car.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+
+	_, err = mrpc.Delete(context.Background(), car.ID)
+	require.NoError(t, err)
+	purged, err := mrpc.Purge(context.Background(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, purged, int64(1))
+	_, err = mrpc.Restore(context.Background(), car.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
 }
 
 func TestGetAllMongo(t *testing.T) {
-	getcar, err := mrpc.GetAll(context.Background())
+	getcar, _, err := mrpc.GetAll(context.Background(), &model.CarFilter{PageSize: 1000})
 	require.NoError(t, err)
 
 	collection := mrpc.client.Database("mdb").Collection("car")
-	count, err := collection.CountDocuments(context.Background(), bson.M{})
+	count, err := collection.CountDocuments(context.Background(), bson.M{"deletedat": nil})
 	require.NoError(t, err)
 	require.Equal(t, len(getcar), int(count))
 }
 
+func TestGetAllPagesMongo(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		err := mrpc.Create(context.Background(), &model.Car{ID: uuid.New(), Brand: "PageBrandMongo", ProductionYear: RandProductionYear()})
+		require.NoError(t, err)
+	}
+	filter := &model.CarFilter{Brand: "PageBrandMongo", SortBy: model.SortByProductionYear, Descending: true, PageSize: 2}
+	var cars []*model.Car
+	for {
+		page, nextPageToken, err := mrpc.GetAll(context.Background(), filter)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page), 2)
+		cars = append(cars, page...)
+		if nextPageToken == "" {
+			break
+		}
+		filter.PageToken = nextPageToken
+	}
+	require.Len(t, cars, 5)
+	for i := 1; i < len(cars); i++ {
+		require.GreaterOrEqual(t, cars[i-1].ProductionYear, cars[i].ProductionYear)
+	}
+}
+
 func TestStrangeDataMongo(t *testing.T) {
 	newage, _ := strconv.Atoi("age")
 	var newbool bool
@@ -98,15 +163,347 @@ func TestStrangeDataMongo(t *testing.T) {
 
 func TestDeleteByFakeIDMongo(t *testing.T) {
 	testModel.ID, _ = uuid.Parse("Some UUID")
-	err := mrpc.Delete(context.Background(), testModel.ID)
-	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+	_, err := mrpc.Delete(context.Background(), testModel.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
 }
 
 func TestNotValidIDMongo(t *testing.T) {
 	var err error
 	testModel.ID, _ = uuid.Parse("1")
-	err = mrpc.Update(context.Background(), &testModel)
-	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+	err = mrpc.Update(context.Background(), &testModel, model.UpdatableCarFields())
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+}
+
+func TestCarHistoryPagesMongo(t *testing.T) {
+	carID := uuid.New()
+	changedAt := time.Now().UTC().Truncate(time.Millisecond)
+	for i := 0; i < 3; i++ {
+		err := mrpc.AddChange(context.Background(), &model.CarChange{
+			ID:        uuid.New(),
+			CarID:     carID,
+			Action:    model.CarUpdated,
+			ChangedAt: changedAt.Add(time.Duration(i) * time.Second),
+			After:     &model.Car{ID: carID, Brand: "HistoryBrandMongo", ProductionYear: int64(2001 + i)},
+		})
+		require.NoError(t, err)
+	}
+	filter := &model.CarHistoryFilter{CarID: carID, PageSize: 2}
+	changes, nextPageToken, err := mrpc.GetHistory(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.NotEmpty(t, nextPageToken)
+	require.Nil(t, changes[0].Before)
+	require.Equal(t, int64(2003), changes[0].After.ProductionYear)
+
+	filter.PageToken = nextPageToken
+	changes, nextPageToken, err = mrpc.GetHistory(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Empty(t, nextPageToken)
+	require.Equal(t, int64(2001), changes[0].After.ProductionYear)
+}
+
+func TestGetManyMongo(t *testing.T) {
+	cars := []*model.Car{
+		{ID: uuid.New(), Brand: "ManyBrand", ProductionYear: RandProductionYear(), Version: 1},
+		{ID: uuid.New(), Brand: "ManyBrand", ProductionYear: RandProductionYear(), Version: 1},
+	}
+	for _, car := range cars {
+		require.NoError(t, mrpc.Create(context.Background(), car))
+	}
+	_, err := mrpc.Delete(context.Background(), cars[1].ID)
+	require.NoError(t, err)
+
+	found, err := mrpc.GetMany(context.Background(), []uuid.UUID{cars[0].ID, cars[1].ID, uuid.New()})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, cars[0].ProductionYear, found[cars[0].ID].ProductionYear)
+}
+
+func TestGetByVINAndDuplicateVINMongo(t *testing.T) {
+	err := mrpc.EnsureIndexes(context.Background())
+	require.NoError(t, err)
+	vin := "11111111111111111"
+	car := model.Car{ID: uuid.New(), Brand: "VINBrandMongo", ProductionYear: RandProductionYear(), Version: 1, VIN: vin, Mileage: 1000}
+	err = mrpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+
+	found, err := mrpc.GetByVIN(context.Background(), vin)
+	require.NoError(t, err)
+	require.Equal(t, car.ID, found.ID)
+	require.Equal(t, car.Mileage, found.Mileage)
+	_, err = mrpc.GetByVIN(context.Background(), "22222222222222222")
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+
+	duplicate := model.Car{ID: uuid.New(), Brand: "VINBrandMongo", ProductionYear: RandProductionYear(), Version: 1, VIN: vin}
+	err = mrpc.Create(context.Background(), &duplicate)
+	require.ErrorIs(t, err, model.ErrDuplicateVIN)
+}
+
+func TestStatsMongo(t *testing.T) {
+	ownerID := uuid.New()
+	cars := []model.Car{
+		{ID: uuid.New(), Brand: "StatsBrandA", ProductionYear: 2001, IsRunning: true, OwnerID: ownerID, Version: 1},
+		{ID: uuid.New(), Brand: "StatsBrandA", ProductionYear: 2001, IsRunning: false, OwnerID: ownerID, Version: 1},
+		{ID: uuid.New(), Brand: "StatsBrandB", ProductionYear: 2002, IsRunning: true, OwnerID: ownerID, Version: 1},
+	}
+	for i := range cars {
+		err := mrpc.Create(context.Background(), &cars[i])
+		require.NoError(t, err)
+	}
+
+	stats, err := mrpc.Stats(context.Background(), ownerID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), stats.Total)
+	require.Equal(t, int64(2), stats.Running)
+	require.Equal(t, []*model.BrandCount{{Brand: "StatsBrandA", Count: 2}, {Brand: "StatsBrandB", Count: 1}}, stats.Brands)
+	require.Equal(t, []*model.ProductionYearCount{{Year: 2001, Count: 2}, {Year: 2002, Count: 1}}, stats.ProductionYears)
+}
+
+func TestCarImagesMongo(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: "ImageBrand", ProductionYear: RandProductionYear(), Version: 1}
+	err := mrpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	var ids []uuid.UUID
+	for i := 0; i < 3; i++ {
+		image := &model.CarImage{ID: uuid.New(), CarID: car.ID, ContentType: "image/png", Size: 10, Checksum: strings.Repeat("a", 64),
+			CreatedAt: time.Now().UTC().Truncate(time.Millisecond)}
+		err = mrpc.AddImage(context.Background(), image)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), image.Position)
+		ids = append(ids, image.ID)
+	}
+
+	images, err := mrpc.GetImages(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, images, 3)
+	require.Equal(t, []uuid.UUID{ids[0], ids[1], ids[2]}, []uuid.UUID{images[0].ID, images[1].ID, images[2].ID})
+}
+
+func TestConcurrentCarImagesMongo(t *testing.T) {
+	err := mrpc.EnsureIndexes(context.Background())
+	require.NoError(t, err)
+	car := model.Car{ID: uuid.New(), Brand: "ImageBrand", ProductionYear: RandProductionYear(), Version: 1}
+	err = mrpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	const uploads = 3
+	errs := make(chan error, uploads)
+	for i := 0; i < uploads; i++ {
+		go func() {
+			errs <- mrpc.AddImage(context.Background(), &model.CarImage{ID: uuid.New(), CarID: car.ID, ContentType: "image/png", Size: 10,
+				Checksum: strings.Repeat("a", 64), CreatedAt: time.Now().UTC().Truncate(time.Millisecond)})
+		}()
+	}
+	for i := 0; i < uploads; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	images, err := mrpc.GetImages(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, images, uploads)
+	for i, image := range images {
+		require.Equal(t, int64(i), image.Position)
+	}
+}
+
+func TestConcurrentRunningAndMaintenanceMongo(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		car := model.Car{ID: uuid.New(), Brand: "ServiceBrand", ProductionYear: RandProductionYear(), Version: 1}
+		err := mrpc.Create(context.Background(), &car)
+		require.NoError(t, err)
+		errs := make(chan error, 2)
+		go func() {
+			errs <- mrpc.Update(context.Background(), &model.Car{ID: car.ID, IsRunning: true, Version: car.Version},
+				[]string{model.CarFieldIsRunning})
+		}()
+		go func() {
+			_, errAdd := mrpc.AddMaintenance(context.Background(), &model.MaintenanceRecord{ID: uuid.New(), CarID: car.ID,
+				Kind: model.MaintenanceRepair, PerformedAt: time.Now().UTC().Truncate(time.Millisecond), Critical: true})
+			errs <- errAdd
+		}()
+		for j := 0; j < 2; j++ {
+			if err = <-errs; err != nil {
+				require.ErrorIs(t, err, model.ErrCarUnderMaintenance)
+			}
+		}
+
+		// the car never runs with an open critical record whichever write comes first
+		stored, err := mrpc.Get(context.Background(), car.ID)
+		require.NoError(t, err)
+		require.False(t, stored.IsRunning)
+	}
+}
+
+func TestMaintenanceMongo(t *testing.T) {
+	ownerID := uuid.New()
+	serviced := model.Car{ID: uuid.New(), Brand: "ServiceBrand", ProductionYear: RandProductionYear(), OwnerID: ownerID, Version: 1, Mileage: 20000, IsRunning: true}
+	overdue := model.Car{ID: uuid.New(), Brand: "ServiceBrand", ProductionYear: RandProductionYear(), OwnerID: ownerID, Version: 1, Mileage: 40000}
+	never := model.Car{ID: uuid.New(), Brand: "ServiceBrand", ProductionYear: RandProductionYear(), OwnerID: ownerID, Version: 1}
+	for _, car := range []*model.Car{&serviced, &overdue, &never} {
+		err := mrpc.Create(context.Background(), car)
+		require.NoError(t, err)
+	}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	for _, car := range []*model.Car{&serviced, &overdue} {
+		_, err := mrpc.AddMaintenance(context.Background(), &model.MaintenanceRecord{ID: uuid.New(), CarID: car.ID, Kind: model.MaintenanceService,
+			PerformedAt: now.Add(-time.Hour), Mileage: 15000, ClosedAt: &now})
+		require.NoError(t, err)
+	}
+	repair := &model.MaintenanceRecord{ID: uuid.New(), CarID: serviced.ID, Kind: model.MaintenanceRepair, PerformedAt: now, Critical: true}
+	stopped, err := mrpc.AddMaintenance(context.Background(), repair)
+	require.NoError(t, err)
+	require.True(t, repair.StoppedCar)
+	require.False(t, stopped.IsRunning)
+	require.Equal(t, serviced.Version+1, stopped.Version)
+	inspection := &model.MaintenanceRecord{ID: uuid.New(), CarID: serviced.ID, Kind: model.MaintenanceInspection, PerformedAt: now, Critical: true}
+	stopped, err = mrpc.AddMaintenance(context.Background(), inspection)
+	require.NoError(t, err)
+	require.False(t, inspection.StoppedCar)
+	require.Nil(t, stopped)
+
+	open, err := mrpc.CountOpenCritical(context.Background(), serviced.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), open)
+	records, err := mrpc.ListMaintenance(context.Background(), serviced.ID)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	running := &model.Car{ID: serviced.ID, IsRunning: true, Version: serviced.Version + 1}
+	err = mrpc.Update(context.Background(), running, []string{model.CarFieldIsRunning})
+	require.ErrorIs(t, err, model.ErrCarUnderMaintenance)
+
+	closed, restarted, err := mrpc.CloseMaintenance(context.Background(), repair.ID, now)
+	require.NoError(t, err)
+	require.NotNil(t, closed.ClosedAt)
+	require.Nil(t, restarted)
+	_, _, err = mrpc.CloseMaintenance(context.Background(), repair.ID, now)
+	require.ErrorIs(t, err, model.ErrMaintenanceNotFound)
+	_, restarted, err = mrpc.CloseMaintenance(context.Background(), inspection.ID, now)
+	require.NoError(t, err)
+	require.True(t, restarted.IsRunning)
+	require.Equal(t, serviced.Version+2, restarted.Version)
+	open, err = mrpc.CountOpenCritical(context.Background(), serviced.ID)
+	require.NoError(t, err)
+	require.Zero(t, open)
+
+	due, err := mrpc.DueForService(context.Background(), &model.ServiceDueFilter{
+		OwnerID:         ownerID,
+		ServicedBefore:  now.Add(-24 * time.Hour),
+		MileageInterval: 15000,
+	})
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.Equal(t, never.ID, due[0].Car.ID)
+	require.Nil(t, due[0].LastServicedAt)
+	require.Equal(t, overdue.ID, due[1].Car.ID)
+	require.Equal(t, int64(15000), due[1].LastServiceMileage)
+}
+
+func TestReservationsMongo(t *testing.T) {
+	userID := uuid.New()
+	car := model.Car{ID: uuid.New(), Brand: "RentBrandMongo", ProductionYear: RandProductionYear(), OwnerID: uuid.New(), Version: 1}
+	err := mrpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	startsAt := time.Now().UTC().Truncate(time.Millisecond).Add(24 * time.Hour)
+	first := &model.Reservation{ID: uuid.New(), CarID: car.ID, UserID: userID, StartsAt: startsAt, EndsAt: startsAt.Add(2 * time.Hour), CreatedAt: startsAt}
+	err = mrpc.CreateReservation(context.Background(), first)
+	require.NoError(t, err)
+
+	overlapping := &model.Reservation{ID: uuid.New(), CarID: car.ID, UserID: uuid.New(), StartsAt: startsAt.Add(time.Hour),
+		EndsAt: startsAt.Add(3 * time.Hour), CreatedAt: startsAt}
+	err = mrpc.CreateReservation(context.Background(), overlapping)
+	require.ErrorIs(t, err, model.ErrReservationConflict)
+	adjacent := &model.Reservation{ID: uuid.New(), CarID: car.ID, UserID: userID, StartsAt: first.EndsAt, EndsAt: first.EndsAt.Add(time.Hour), CreatedAt: startsAt}
+	err = mrpc.CreateReservation(context.Background(), adjacent)
+	require.NoError(t, err)
+
+	canceled, err := mrpc.CancelReservation(context.Background(), first.ID, startsAt)
+	require.NoError(t, err)
+	require.NotNil(t, canceled.CanceledAt)
+	_, err = mrpc.CancelReservation(context.Background(), first.ID, startsAt)
+	require.ErrorIs(t, err, model.ErrReservationNotFound)
+	err = mrpc.CreateReservation(context.Background(), overlapping)
+	require.ErrorIs(t, err, model.ErrReservationConflict)
+	overlapping.EndsAt = first.EndsAt
+	err = mrpc.CreateReservation(context.Background(), overlapping)
+	require.NoError(t, err)
+
+	reservations, err := mrpc.ListReservations(context.Background(), &model.ReservationFilter{UserID: userID})
+	require.NoError(t, err)
+	require.Len(t, reservations, 1)
+	require.Equal(t, adjacent.ID, reservations[0].ID)
+	reservations, err = mrpc.ListReservations(context.Background(), &model.ReservationFilter{CarID: car.ID, IncludeCanceled: true})
+	require.NoError(t, err)
+	require.Len(t, reservations, 3)
+	require.Equal(t, first.ID, reservations[0].ID)
+}
+
+func TestLabelSelectorMongo(t *testing.T) {
+	fleet := uuid.NewString()
+	minsk := model.Car{ID: uuid.New(), Brand: "LabelBrand", ProductionYear: RandProductionYear(), Version: 1,
+		Labels: map[string]string{"fleet": fleet, "region": "minsk", "pool": "main"}}
+	reserve := model.Car{ID: uuid.New(), Brand: "LabelBrand", ProductionYear: RandProductionYear(), Version: 1,
+		Labels: map[string]string{"fleet": fleet, "region": "minsk", "pool": "reserve"}}
+	brest := model.Car{ID: uuid.New(), Brand: "LabelBrand", ProductionYear: RandProductionYear(), Version: 1,
+		Labels: map[string]string{"fleet": fleet, "region": "brest"}}
+	for _, car := range []*model.Car{&minsk, &reserve, &brest} {
+		err := mrpc.Create(context.Background(), car)
+		require.NoError(t, err)
+	}
+	selected := func(selector string) []uuid.UUID {
+		labelSelector, err := model.ParseLabelSelector("fleet=" + fleet + "," + selector)
+		require.NoError(t, err)
+		cars, _, err := mrpc.GetAll(context.Background(), &model.CarFilter{LabelSelector: labelSelector, PageSize: 10})
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, 0, len(cars))
+		for _, car := range cars {
+			ids = append(ids, car.ID)
+		}
+		return ids
+	}
+	require.ElementsMatch(t, []uuid.UUID{minsk.ID}, selected("region=minsk,pool!=reserve"))
+	require.ElementsMatch(t, []uuid.UUID{minsk.ID, brest.ID}, selected("pool notin (reserve)"))
+	require.ElementsMatch(t, []uuid.UUID{minsk.ID, reserve.ID, brest.ID}, selected("region in (minsk,brest)"))
+	require.ElementsMatch(t, []uuid.UUID{minsk.ID, reserve.ID}, selected("pool"))
+	require.ElementsMatch(t, []uuid.UUID{brest.ID}, selected("!pool"))
+
+	brest.Labels = nil
+	err := mrpc.Update(context.Background(), &brest, []string{model.CarFieldLabels})
+	require.NoError(t, err)
+	car, err := mrpc.Get(context.Background(), brest.ID)
+	require.NoError(t, err)
+	require.Nil(t, car.Labels)
+	car, err = mrpc.Get(context.Background(), minsk.ID)
+	require.NoError(t, err)
+	require.Equal(t, minsk.Labels, car.Labels)
+}
+
+func TestMongoBrandCatalog(t *testing.T) {
+	err := mrpc.EnsureIndexes(context.Background())
+	require.NoError(t, err)
+	suffix := uuid.NewString()[:8]
+	brand := &model.Brand{ID: uuid.New(), Name: "Volkswagen" + suffix, Aliases: []string{"VW" + suffix}}
+	err = mrpc.CreateBrand(context.Background(), brand)
+	require.NoError(t, err)
+	err = mrpc.CreateBrand(context.Background(), &model.Brand{ID: uuid.New(), Name: "vw" + suffix})
+	require.ErrorIs(t, err, model.ErrDuplicateBrand)
+
+	resolved, err := mrpc.ResolveBrand(context.Background(), " vw"+suffix)
+	require.NoError(t, err)
+	require.Equal(t, brand.ID, resolved.ID)
+	require.Equal(t, brand.Aliases, resolved.Aliases)
+	_, err = mrpc.ResolveBrand(context.Background(), "Unknown"+suffix)
+	require.ErrorIs(t, err, model.ErrUnknownBrand)
+
+	car := model.Car{ID: uuid.New(), Brand: "vw" + suffix, ProductionYear: RandProductionYear(), Version: 1}
+	err = mrpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	moved, err := mrpc.SetCarsBrand(context.Background(), car.Brand, brand)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{car.ID}, moved)
+	stored, err := mrpc.Get(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Equal(t, brand.Name, stored.Brand)
+	require.Equal(t, brand.ID, stored.BrandID)
 }
 
 func recoveryFunction() {
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// NoopCarCache is the cache which caches nothing, every read is a miss and every write is dropped.
+type NoopCarCache struct{}
+
+// NewNoopCarCache creates the cache which caches nothing.
+func NewNoopCarCache() *NoopCarCache {
+	return &NoopCarCache{}
+}
+
+// GetCache always returns redis.Nil.
+func (NoopCarCache) GetCache(_ context.Context, _ uuid.UUID) (*model.Car, error) {
+	return nil, redis.Nil
+}
+
+// GetCacheMany always returns no cars.
+func (NoopCarCache) GetCacheMany(_ context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error) {
+	return make(map[uuid.UUID]*model.Car, len(ids)), nil
+}
+
+// GetCacheByVIN always returns redis.Nil.
+func (NoopCarCache) GetCacheByVIN(_ context.Context, _ string) (*model.Car, error) {
+	return nil, redis.Nil
+}
+
+// SetCache does nothing.
+func (NoopCarCache) SetCache(_ context.Context, _ *model.Car) error {
+	return nil
+}
+
+// SetCacheMany does nothing.
+func (NoopCarCache) SetCacheMany(_ context.Context, _ []*model.Car) error {
+	return nil
+}
+
+// SetCacheNotFound does nothing.
+func (NoopCarCache) SetCacheNotFound(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+// AcquireCarLoadLease always gives the lease, there is no cache to wait for.
+func (NoopCarCache) AcquireCarLoadLease(_ context.Context, _ uuid.UUID) (string, error) {
+	return uuid.NewString(), nil
+}
+
+// ReleaseCarLoadLease does nothing.
+func (NoopCarCache) ReleaseCarLoadLease(_ context.Context, _ uuid.UUID, _ string) error {
+	return nil
+}
+
+// DeleteCache does nothing.
+func (NoopCarCache) DeleteCache(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+// DeleteCacheMany does nothing.
+func (NoopCarCache) DeleteCacheMany(_ context.Context, _ []uuid.UUID) error {
+	return nil
+}
+
+// CachedCarIDs always returns no IDs.
+func (NoopCarCache) CachedCarIDs(_ context.Context, _ uint64, _ int64) ([]uuid.UUID, uint64, error) {
+	return nil, 0, nil
+}
+
+// SetStatsCache does nothing.
+func (NoopCarCache) SetStatsCache(_ context.Context, _ uuid.UUID, _ *model.CarStats, _ time.Duration) error {
+	return nil
+}
+
+// GetStatsCache always returns redis.Nil.
+func (NoopCarCache) GetStatsCache(_ context.Context, _ uuid.UUID) (*model.CarStats, error) {
+	return nil, redis.Nil
+}
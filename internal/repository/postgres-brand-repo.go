@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// brandColumns are the columns of a brand in the order scanBrand reads them.
+const brandColumns = "brand.id, brand.name, brand.aliases"
+
+// scanBrand reads a brand selected with brandColumns.
+func scanBrand(row pgx.Row, brand *model.Brand) error {
+	return row.Scan(&brand.ID, &brand.Name, &brand.Aliases)
+}
+
+// pgAliases returns the aliases to store in the aliases column, which holds an empty array for a brand without aliases.
+func pgAliases(aliases []string) []string {
+	if aliases == nil {
+		return []string{}
+	}
+	return aliases
+}
+
+// CreateBrand inserts a brand and the keys it is found by, model.ErrDuplicateBrand is returned if another brand has one of the keys.
+func (p *PgRepository) CreateBrand(ctx context.Context, brand *model.Brand) error {
+	err := p.inTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "INSERT INTO brand (id, name, aliases) VALUES ($1, $2, $3)", brand.ID, brand.Name, pgAliases(brand.Aliases))
+		if err != nil {
+			return fmt.Errorf("error in method tx.Exec(): %w", err)
+		}
+		return insertBrandKeys(ctx, tx, brand)
+	})
+	if err != nil {
+		return fmt.Errorf("PgRepository-CreateBrand: %w", err)
+	}
+	return nil
+}
+
+// insertBrandKeys inserts the keys of the brand with tx.
+func insertBrandKeys(ctx context.Context, tx pgx.Tx, brand *model.Brand) error {
+	for _, key := range brand.Keys() {
+		_, err := tx.Exec(ctx, "INSERT INTO brandkey (key, brandid) VALUES ($1, $2)", key, brand.ID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "brandkey_pkey" {
+				return fmt.Errorf("%w: %s", model.ErrDuplicateBrand, key)
+			}
+			return fmt.Errorf("error in method tx.Exec(): %w", err)
+		}
+	}
+	return nil
+}
+
+// GetBrand retrieves a brand of the catalog by its ID.
+func (p *PgRepository) GetBrand(ctx context.Context, id uuid.UUID) (*model.Brand, error) {
+	var brand model.Brand
+	err := scanBrand(p.pool.QueryRow(ctx, "SELECT "+brandColumns+" FROM brand WHERE id = $1", id), &brand)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PgRepository-GetBrand: %w", model.ErrBrandNotFound)
+		}
+		return nil, fmt.Errorf("PgRepository-GetBrand: error in method scanBrand(): %w", err)
+	}
+	return &brand, nil
+}
+
+// ListBrands retrieves all brands of the catalog ordered by name.
+func (p *PgRepository) ListBrands(ctx context.Context) ([]*model.Brand, error) {
+	rows, err := p.pool.Query(ctx, "SELECT "+brandColumns+" FROM brand ORDER BY name, id")
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-ListBrands: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	var brands []*model.Brand
+	for rows.Next() {
+		var brand model.Brand
+		err = scanBrand(rows, &brand)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-ListBrands: error in method scanBrand(): %w", err)
+		}
+		brands = append(brands, &brand)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-ListBrands: error iterating rows: %w", err)
+	}
+	return brands, nil
+}
+
+// UpdateBrand replaces the name and the aliases of a brand, the cars of a renamed brand get the new name and a new version.
+// It returns the IDs of the renamed cars.
+func (p *PgRepository) UpdateBrand(ctx context.Context, brand *model.Brand) ([]uuid.UUID, error) {
+	var renamed []uuid.UUID
+	err := p.inTx(ctx, func(tx pgx.Tx) error {
+		res, err := tx.Exec(ctx, "UPDATE brand SET name = $2, aliases = $3 WHERE id = $1", brand.ID, brand.Name, pgAliases(brand.Aliases))
+		if err != nil {
+			return fmt.Errorf("error in method tx.Exec(): %w", err)
+		}
+		if res.RowsAffected() == 0 {
+			return model.ErrBrandNotFound
+		}
+		_, err = tx.Exec(ctx, "DELETE FROM brandkey WHERE brandid = $1", brand.ID)
+		if err != nil {
+			return fmt.Errorf("error in method tx.Exec(): %w", err)
+		}
+		err = insertBrandKeys(ctx, tx, brand)
+		if err != nil {
+			return err
+		}
+		renamed, err = queryIDs(ctx, tx, "UPDATE car SET brand = $2, version = version + 1 WHERE brandid = $1 AND brand <> $2 RETURNING id",
+			brand.ID, brand.Name)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-UpdateBrand: %w", err)
+	}
+	return renamed, nil
+}
+
+// DeleteBrand removes a brand and its keys from the catalog, model.ErrBrandInUse is returned if the brand still has cars.
+func (p *PgRepository) DeleteBrand(ctx context.Context, id uuid.UUID) error {
+	res, err := p.pool.Exec(ctx, "DELETE FROM brand WHERE id = $1", id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return fmt.Errorf("PgRepository-DeleteBrand: %w", model.ErrBrandInUse)
+		}
+		return fmt.Errorf("PgRepository-DeleteBrand: error in method p.pool.Exec(): %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("PgRepository-DeleteBrand: %w", model.ErrBrandNotFound)
+	}
+	return nil
+}
+
+// ResolveBrand finds the brand whose name or alias has the same key as name, model.ErrUnknownBrand is returned if there is none.
+func (p *PgRepository) ResolveBrand(ctx context.Context, name string) (*model.Brand, error) {
+	var brand model.Brand
+	err := scanBrand(p.pool.QueryRow(ctx, "SELECT "+brandColumns+" FROM brandkey JOIN brand ON brand.id = brandkey.brandid WHERE brandkey.key = $1",
+		model.BrandKey(name)), &brand)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PgRepository-ResolveBrand: %w: %s", model.ErrUnknownBrand, name)
+		}
+		return nil, fmt.Errorf("PgRepository-ResolveBrand: error in method scanBrand(): %w", err)
+	}
+	return &brand, nil
+}
+
+// CarBrands retrieves the distinct brands of all car records, deleted records included.
+func (p *PgRepository) CarBrands(ctx context.Context) ([]string, error) {
+	rows, err := p.pool.Query(ctx, "SELECT DISTINCT brand FROM car WHERE brand IS NOT NULL ORDER BY brand")
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-CarBrands: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	var brands []string
+	for rows.Next() {
+		var brand string
+		err = rows.Scan(&brand)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-CarBrands: error in method rows.Scan(): %w", err)
+		}
+		brands = append(brands, brand)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-CarBrands: error iterating rows: %w", err)
+	}
+	return brands, nil
+}
+
+// SetCarsBrand moves the car records with the brand from to the brand of the catalog, deleted records included,
+// the moved cars get a new version. It returns the IDs of the moved cars.
+func (p *PgRepository) SetCarsBrand(ctx context.Context, from string, brand *model.Brand) ([]uuid.UUID, error) {
+	ids, err := queryIDs(ctx, p.pool, `UPDATE car SET brand = $2, brandid = $3, version = version + 1
+		WHERE brand = $1 AND (brand <> $2 OR brandid IS DISTINCT FROM $3) RETURNING id`, from, brand.Name, brand.ID)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-SetCarsBrand: %w", err)
+	}
+	return ids, nil
+}
+
+// idQuerier runs a query returning rows either on the pool or inside a transaction.
+type idQuerier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// queryIDs runs the query with db and reads the IDs it returns.
+func queryIDs(ctx context.Context, db idQuerier, query string, args ...interface{}) ([]uuid.UUID, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error in method db.Query(): %w", err)
+	}
+	defer rows.Close()
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("error in method rows.Scan(): %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+)
+
+// AddChange inserts an entry of the history of a car into the database.
+func (p *PgRepository) AddChange(ctx context.Context, change *model.CarChange) error {
+	before, err := marshalCarSnapshot(change.Before)
+	if err != nil {
+		return fmt.Errorf("PgRepository-AddChange: %w", err)
+	}
+	after, err := marshalCarSnapshot(change.After)
+	if err != nil {
+		return fmt.Errorf("PgRepository-AddChange: %w", err)
+	}
+	_, err = p.pool.Exec(ctx, "INSERT INTO carhistory (id, carid, actorid, action, changedat, before, after) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		change.ID, change.CarID, change.ActorID, string(change.Action), change.ChangedAt, before, after)
+	if err != nil {
+		return fmt.Errorf("PgRepository-AddChange: error in method p.pool.Exec(): %w", err)
+	}
+	return nil
+}
+
+// GetHistory retrieves a page of the history of a car, newest changes first, and returns the token of the next page.
+func (p *PgRepository) GetHistory(ctx context.Context, filter *model.CarHistoryFilter) ([]*model.CarChange, string, error) {
+	cursorPos, err := decodeCarChangeCursor(filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("PgRepository-GetHistory: %w", err)
+	}
+	query := "SELECT id, carid, actorid, action, changedat, before, after FROM carhistory WHERE carid = $1"
+	args := []interface{}{filter.CarID}
+	if cursorPos != nil {
+		query += " AND (changedat, id) < ($2, $3)"
+		args = append(args, cursorPos.ChangedAt, cursorPos.ID)
+	}
+	query += fmt.Sprintf(" ORDER BY changedat DESC, id DESC LIMIT %d", filter.PageSize+1)
+	rows, err := p.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("PgRepository-GetHistory: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	var changes []*model.CarChange
+	for rows.Next() {
+		var (
+			change        model.CarChange
+			action        string
+			before, after []byte
+		)
+		err = rows.Scan(&change.ID, &change.CarID, &change.ActorID, &action, &change.ChangedAt, &before, &after)
+		if err != nil {
+			return nil, "", fmt.Errorf("PgRepository-GetHistory: error in method rows.Scan(): %w", err)
+		}
+		change.Action = model.CarChangeAction(action)
+		change.Before, err = unmarshalCarSnapshot(before)
+		if err != nil {
+			return nil, "", fmt.Errorf("PgRepository-GetHistory: %w", err)
+		}
+		change.After, err = unmarshalCarSnapshot(after)
+		if err != nil {
+			return nil, "", fmt.Errorf("PgRepository-GetHistory: %w", err)
+		}
+		changes = append(changes, &change)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("PgRepository-GetHistory: error in rows: %w", err)
+	}
+	return carChangePage(filter, changes)
+}
+
+// marshalCarSnapshot encodes a car stored in the history, a nil car is stored as NULL.
+func marshalCarSnapshot(car *model.Car) ([]byte, error) {
+	if car == nil {
+		return nil, nil
+	}
+	snapshot, err := json.Marshal(car)
+	if err != nil {
+		return nil, fmt.Errorf("marshalCarSnapshot: error in method json.Marshal(): %w", err)
+	}
+	return snapshot, nil
+}
+
+// unmarshalCarSnapshot decodes a car stored in the history, NULL is decoded as a nil car.
+func unmarshalCarSnapshot(snapshot []byte) (*model.Car, error) {
+	if len(snapshot) == 0 {
+		return nil, nil
+	}
+	var car model.Car
+	err := json.Unmarshal(snapshot, &car)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshalCarSnapshot: error in method json.Unmarshal(): %w", err)
+	}
+	return &car, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// imagePositionAttempts is how many times an image is placed after the other images of the car
+// when the position is taken by an image uploaded at the same time.
+const imagePositionAttempts = 5
+
+// AddImage inserts the metadata of an image of a car into the database, the image is placed after the other images of the car.
+// The position is unique for the car, it is taken again if a concurrent upload has taken it first.
+func (p *PgRepository) AddImage(ctx context.Context, image *model.CarImage) error {
+	var err error
+	for attempt := 0; attempt < imagePositionAttempts; attempt++ {
+		err = p.pool.QueryRow(ctx, `INSERT INTO carimage (id, carid, contenttype, size, checksum, position, createdat)
+		SELECT $1, $2, $3, $4, $5, coalesce(max(position) + 1, 0), $6 FROM carimage WHERE carid = $2 RETURNING position`,
+			image.ID, image.CarID, image.ContentType, image.Size, image.Checksum, image.CreatedAt).Scan(&image.Position)
+		if !isImagePositionTaken(err) {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("PgRepository-AddImage: error in method p.pool.QueryRow(): %w", err)
+	}
+	return nil
+}
+
+// isImagePositionTaken reports whether err is the unique violation of the position of an image of a car.
+func isImagePositionTaken(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "carimage_carid_position_key"
+}
+
+// GetImages retrieves the metadata of the images of a car in their order.
+func (p *PgRepository) GetImages(ctx context.Context, carID uuid.UUID) ([]*model.CarImage, error) {
+	rows, err := p.pool.Query(ctx, `SELECT id, carid, contenttype, size, checksum, position, createdat FROM carimage
+		WHERE carid = $1 ORDER BY position, createdat`, carID)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-GetImages: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	var images []*model.CarImage
+	for rows.Next() {
+		var image model.CarImage
+		err = rows.Scan(&image.ID, &image.CarID, &image.ContentType, &image.Size, &image.Checksum, &image.Position, &image.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-GetImages: error in method rows.Scan(): %w", err)
+		}
+		images = append(images, &image)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-GetImages: error iterating rows: %w", err)
+	}
+	return images, nil
+}
+
+// SetPrimaryImage moves the image to the first position, the images which were before it move one position down.
+func (p *PgRepository) SetPrimaryImage(ctx context.Context, carID, imageID uuid.UUID) error {
+	tag, err := p.pool.Exec(ctx, `UPDATE carimage SET position = CASE WHEN id = $2 THEN 0 ELSE position + 1 END
+		WHERE carid = $1 AND position <= (SELECT position FROM carimage WHERE id = $2 AND carid = $1)`, carID, imageID)
+	if err != nil {
+		return fmt.Errorf("PgRepository-SetPrimaryImage: error in method p.pool.Exec(): %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("PgRepository-SetPrimaryImage: %w", model.ErrCarImageNotFound)
+	}
+	return nil
+}
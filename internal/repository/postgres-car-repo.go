@@ -3,11 +3,15 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/distuurbia/firstTaskArtyom/internal/model"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -23,67 +27,552 @@ func NewPgRepository(pool *pgxpool.Pool) *PgRepository {
 	}
 }
 
+// carColumns are the columns of a car record in the order scanCar reads them.
+const carColumns = "id, brand, productionyear, isrunning, ownerid, version, deletedat, coalesce(vin, ''), model, mileage, color, price, labels, brandid"
+
+// scanCar reads a row selected with carColumns into car, a car without labels gets nil labels
+// and a car which is not in the brand catalog yet gets uuid.Nil as the brand ID.
+func scanCar(row pgx.Row, car *model.Car) error {
+	var brandID *uuid.UUID
+	err := row.Scan(&car.ID, &car.Brand, &car.ProductionYear, &car.IsRunning, &car.OwnerID, &car.Version, &car.DeletedAt,
+		&car.VIN, &car.Model, &car.Mileage, &car.Color, &car.Price, &car.Labels, &brandID)
+	if len(car.Labels) == 0 {
+		car.Labels = nil
+	}
+	car.BrandID = uuid.Nil
+	if brandID != nil {
+		car.BrandID = *brandID
+	}
+	return err
+}
+
+// pgBrandID returns the brand ID to store in the brandid column, which is NULL for a car which is not in the brand catalog.
+func pgBrandID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+// pgLabels returns the labels to store in the labels column, which holds an empty object for a car without labels.
+func pgLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return map[string]string{}
+	}
+	return labels
+}
+
+// pgExecutor runs the queries of a car repository method either on the pool or inside a transaction.
+type pgExecutor interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
 // Create creates a new car record in the database.
 func (p *PgRepository) Create(ctx context.Context, car *model.Car) error {
-	_, err := p.pool.Exec(ctx, "INSERT INTO car (id, brand, productionyear, isrunning) VALUES ($1, $2, $3, $4)", car.ID, car.Brand, car.ProductionYear, car.IsRunning)
+	err := createCar(ctx, p.pool, car)
+	if err != nil {
+		return fmt.Errorf("PgRepository-Create: %w", err)
+	}
+	return nil
+}
+
+// createCar inserts a car record with db.
+func createCar(ctx context.Context, db pgExecutor, car *model.Car) error {
+	_, err := db.Exec(ctx, `INSERT INTO car (id, brand, productionyear, isrunning, ownerid, version, vin, model, mileage, color, price, labels, brandid)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9, $10, $11, $12, $13)`,
+		car.ID, car.Brand, car.ProductionYear, car.IsRunning, car.OwnerID, car.Version, car.VIN, car.Model, car.Mileage, car.Color, car.Price,
+		pgLabels(car.Labels), pgBrandID(car.BrandID))
 	if err != nil {
-		return fmt.Errorf("PgRepository-Create: error in method r.pool.Exec(): %w", err)
+		return fmt.Errorf("error in method db.Exec(): %w", pgWriteError(err))
 	}
 	return nil
 }
 
-// Get retrieves a car record from the database based on the provided ID.
+// Get retrieves a car record which is not deleted from the database based on the provided ID.
 func (p *PgRepository) Get(ctx context.Context, id uuid.UUID) (*model.Car, error) {
 	var car model.Car
-	err := p.pool.QueryRow(ctx, "SELECT id, brand, productionyear, isrunning FROM car WHERE id = $1", id).Scan(&car.ID, &car.Brand, &car.ProductionYear, &car.IsRunning)
+	err := scanCar(p.pool.QueryRow(ctx, "SELECT "+carColumns+" FROM car WHERE id = $1 AND deletedat IS NULL", id), &car)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PgRepository-Get: %w", model.ErrCarNotFound)
+		}
 		return nil, fmt.Errorf("PgRepository-Get: error in method r.pool.QuerryRow(): %w", err)
 	}
 	return &car, nil
 }
 
-// Delete removes a car record from the database based on the provided ID.
-func (p *PgRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	res, err := p.pool.Exec(ctx, "DELETE FROM car WHERE id = $1", id)
+// GetByVIN retrieves a car record which is not deleted from the database by its VIN.
+func (p *PgRepository) GetByVIN(ctx context.Context, vin string) (*model.Car, error) {
+	var car model.Car
+	err := scanCar(p.pool.QueryRow(ctx, "SELECT "+carColumns+" FROM car WHERE vin = $1 AND deletedat IS NULL", vin), &car)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PgRepository-GetByVIN: %w", model.ErrCarNotFound)
+		}
+		return nil, fmt.Errorf("PgRepository-GetByVIN: error in method p.pool.QueryRow(): %w", err)
+	}
+	return &car, nil
+}
+
+// Delete marks a car record as deleted, increments its version and returns the deleted car.
+// The record stays in the database until it is purged.
+func (p *PgRepository) Delete(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	car, err := deleteCar(ctx, p.pool, id)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-Delete: %w", err)
+	}
+	return car, nil
+}
+
+// deleteCar marks a car record as deleted with db, increments its version and returns the deleted car.
+// It returns model.ErrCarNotFound if there is no such car which is not deleted.
+func deleteCar(ctx context.Context, db pgExecutor, id uuid.UUID) (*model.Car, error) {
+	var car model.Car
+	err := scanCar(db.QueryRow(ctx, "UPDATE car SET deletedat = now(), version = version + 1 WHERE id = $1 AND deletedat IS NULL RETURNING "+carColumns, id), &car)
 	if err != nil {
-		return fmt.Errorf("PgRepository-Delete: error in method r.pool.Exec(): %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrCarNotFound
+		}
+		return nil, fmt.Errorf("error in method db.QueryRow(): %w", err)
 	}
-	if res.RowsAffected() == 0 {
-		return pgx.ErrNoRows
+	return &car, nil
+}
+
+// Update updates the given fields of a car record in the database if its stored version equals car.Version and increments the version.
+// car is filled with the updated record, the fields which weren't given are read from the database.
+// The car is updated in a transaction, so a car set running stays locked against new maintenance records until it is updated.
+func (p *PgRepository) Update(ctx context.Context, car *model.Car, fields []string) error {
+	err := p.inTx(ctx, func(tx pgx.Tx) error {
+		return updateCar(ctx, tx, car, fields)
+	})
+	if err != nil {
+		return fmt.Errorf("PgRepository-Update: %w", err)
 	}
 	return nil
 }
 
-// Update updates a car record in the database.
-func (p *PgRepository) Update(ctx context.Context, car *model.Car) error {
-	res, err := p.pool.Exec(ctx, "UPDATE car SET brand = $1, productionyear = $2, isrunning = $3 WHERE id = $4", car.Brand, car.ProductionYear, car.IsRunning, car.ID)
+// updateCar updates the given fields of a car record with db if its stored version equals car.Version and increments the version,
+// car is filled with the updated record.
+func updateCar(ctx context.Context, db pgExecutor, car *model.Car, fields []string) error {
+	var sets []string
+	var args []interface{}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	for _, field := range fields {
+		switch field {
+		case model.CarFieldBrand:
+			sets = append(sets, "brand = "+arg(car.Brand), "brandid = "+arg(pgBrandID(car.BrandID)))
+		case model.CarFieldProductionYear:
+			sets = append(sets, "productionyear = "+arg(car.ProductionYear))
+		case model.CarFieldIsRunning:
+			if car.IsRunning {
+				err := checkNoOpenCritical(ctx, db, car.ID)
+				if err != nil {
+					return err
+				}
+			}
+			sets = append(sets, "isrunning = "+arg(car.IsRunning))
+		case model.CarFieldVIN:
+			sets = append(sets, "vin = NULLIF("+arg(car.VIN)+", '')")
+		case model.CarFieldModel:
+			sets = append(sets, "model = "+arg(car.Model))
+		case model.CarFieldMileage:
+			sets = append(sets, "mileage = "+arg(car.Mileage))
+		case model.CarFieldColor:
+			sets = append(sets, "color = "+arg(car.Color))
+		case model.CarFieldPrice:
+			sets = append(sets, "price = "+arg(car.Price))
+		case model.CarFieldLabels:
+			sets = append(sets, "labels = "+arg(pgLabels(car.Labels)))
+		default:
+			return fmt.Errorf("%w: %s", model.ErrUnknownCarField, field)
+		}
+	}
+	sets = append(sets, "version = version + 1")
+	query := fmt.Sprintf("UPDATE car SET %s WHERE id = %s AND version = %s AND deletedat IS NULL RETURNING %s",
+		strings.Join(sets, ", "), arg(car.ID), arg(car.Version), carColumns)
+	var updated model.Car
+	err := scanCar(db.QueryRow(ctx, query, args...), &updated)
+	if err == nil {
+		*car = updated
+		return nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("error in method db.QueryRow(): %w", pgWriteError(err))
+	}
+	var currentVersion int64
+	err = db.QueryRow(ctx, "SELECT version FROM car WHERE id = $1 AND deletedat IS NULL", car.ID).Scan(&currentVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrCarNotFound
+		}
+		return fmt.Errorf("error in method db.QueryRow(): %w", err)
+	}
+	return &model.VersionConflictError{CurrentVersion: currentVersion}
+}
+
+// checkNoOpenCritical returns model.ErrCarUnderMaintenance if the car has an open critical maintenance record.
+// The car is locked first, so a record added at the same time is either seen or added after the car is updated,
+// db has to be a transaction for the lock to last.
+func checkNoOpenCritical(ctx context.Context, db pgExecutor, carID uuid.UUID) error {
+	_, err := db.Exec(ctx, "SELECT 1 FROM car WHERE id = $1 FOR UPDATE", carID)
+	if err != nil {
+		return fmt.Errorf("error in method db.Exec(): %w", err)
+	}
+	var open bool
+	err = db.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM maintenance WHERE carid = $1 AND critical AND closedat IS NULL)", carID).Scan(&open)
 	if err != nil {
-		return fmt.Errorf("PgRepository-Update: error in method r.pool.Exec(): %w", err)
+		return fmt.Errorf("error in method db.QueryRow(): %w", err)
 	}
-	if res.RowsAffected() == 0 {
-		return pgx.ErrNoRows
+	if open {
+		return model.ErrCarUnderMaintenance
+	}
+	return nil
+}
+
+// BatchCreate inserts all the car records in one transaction, none of them are inserted if one fails.
+func (p *PgRepository) BatchCreate(ctx context.Context, cars []*model.Car) error {
+	err := p.inTx(ctx, func(tx pgx.Tx) error {
+		for i, car := range cars {
+			err := createCar(ctx, tx, car)
+			if err != nil {
+				return &model.BatchItemError{Index: i, Err: err}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("PgRepository-BatchCreate: %w", err)
 	}
 	return nil
 }
 
-// GetAll retrieves all car records from the database.
-func (p *PgRepository) GetAll(ctx context.Context) ([]*model.Car, error) {
+// BatchUpdate applies all the updates in one transaction, none of them are applied if one fails.
+// The cars of the updates are filled with the updated records only after the transaction is committed.
+func (p *PgRepository) BatchUpdate(ctx context.Context, updates []*model.CarUpdate) error {
+	updated := make([]model.Car, len(updates))
+	err := p.inTx(ctx, func(tx pgx.Tx) error {
+		for i, update := range updates {
+			updated[i] = *update.Car
+			err := updateCar(ctx, tx, &updated[i], update.Fields)
+			if err != nil {
+				return &model.BatchItemError{Index: i, Err: err}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("PgRepository-BatchUpdate: %w", err)
+	}
+	for i, update := range updates {
+		*update.Car = updated[i]
+	}
+	return nil
+}
+
+// BatchDelete marks all the car records as deleted in one transaction, none of them are marked if one fails.
+// The deleted cars are returned in the order of the IDs.
+func (p *PgRepository) BatchDelete(ctx context.Context, ids []uuid.UUID) ([]*model.Car, error) {
+	deleted := make([]*model.Car, len(ids))
+	err := p.inTx(ctx, func(tx pgx.Tx) error {
+		for i, id := range ids {
+			car, err := deleteCar(ctx, tx, id)
+			if err != nil {
+				return &model.BatchItemError{Index: i, Err: err}
+			}
+			deleted[i] = car
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-BatchDelete: %w", err)
+	}
+	return deleted, nil
+}
+
+// GetMany retrieves the car records which are not deleted by their IDs in one query,
+// the IDs without such a record are missing from the result.
+func (p *PgRepository) GetMany(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error) {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	rows, err := p.pool.Query(ctx, "SELECT "+carColumns+" FROM car WHERE id = ANY($1::uuid[]) AND deletedat IS NULL", values)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-GetMany: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	cars := make(map[uuid.UUID]*model.Car, len(ids))
+	for rows.Next() {
+		var car model.Car
+		err = scanCar(rows, &car)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-GetMany: error in method rows.Scan(): %w", err)
+		}
+		cars[car.ID] = &car
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-GetMany: error iterating rows: %w", err)
+	}
+	return cars, nil
+}
+
+// ExistingIDs reports which of the IDs belong to car records in the database, deleted records included.
+func (p *PgRepository) ExistingIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	rows, err := p.pool.Query(ctx, "SELECT id FROM car WHERE id = ANY($1::uuid[])", values)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-ExistingIDs: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	existing := make(map[uuid.UUID]bool)
+	for rows.Next() {
+		var id uuid.UUID
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-ExistingIDs: error in method rows.Scan(): %w", err)
+		}
+		existing[id] = true
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-ExistingIDs: error iterating rows: %w", err)
+	}
+	return existing, nil
+}
+
+// Stats counts the cars which are not deleted by brand, by production year and by whether they are running,
+// the cars of every owner are counted if ownerID is uuid.Nil.
+func (p *PgRepository) Stats(ctx context.Context, ownerID uuid.UUID) (*model.CarStats, error) {
+	query := `SELECT GROUPING(brand, productionyear, isrunning), coalesce(brand, ''), coalesce(productionyear, 0),
+		coalesce(isrunning, false), count(*) FROM car WHERE deletedat IS NULL AND ($1::uuid IS NULL OR ownerid = $1)
+		GROUP BY GROUPING SETS ((brand), (productionyear), (isrunning)) ORDER BY 1, 2, 3`
+	var owner *uuid.UUID
+	if ownerID != uuid.Nil {
+		owner = &ownerID
+	}
+	rows, err := p.pool.Query(ctx, query, owner)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-Stats: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	// GROUPING sets the bits of the columns which are not grouped in the row, brand being the highest one
+	const (
+		groupedByBrand          = 0b011
+		groupedByProductionYear = 0b101
+		groupedByIsRunning      = 0b110
+	)
+	stats := &model.CarStats{}
+	for rows.Next() {
+		var (
+			grouping       int64
+			brand          string
+			productionYear int64
+			isRunning      bool
+			count          int64
+		)
+		err = rows.Scan(&grouping, &brand, &productionYear, &isRunning, &count)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-Stats: error in method rows.Scan(): %w", err)
+		}
+		switch grouping {
+		case groupedByBrand:
+			stats.Brands = append(stats.Brands, &model.BrandCount{Brand: brand, Count: count})
+		case groupedByProductionYear:
+			stats.ProductionYears = append(stats.ProductionYears, &model.ProductionYearCount{Year: productionYear, Count: count})
+		case groupedByIsRunning:
+			stats.Total += count
+			if isRunning {
+				stats.Running += count
+			}
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-Stats: error iterating rows: %w", err)
+	}
+	return stats, nil
+}
+
+// pgLabelCondition builds the condition of a label requirement out of the containment and key existence operators,
+// which the GIN index on the labels serves.
+func pgLabelCondition(requirement model.LabelRequirement, arg func(value interface{}) string) string {
+	contains := func(value string) string {
+		return "labels @> " + arg(map[string]string{requirement.Key: value})
+	}
+	containsAny := func() string {
+		alternatives := make([]string, 0, len(requirement.Values))
+		for _, value := range requirement.Values {
+			alternatives = append(alternatives, contains(value))
+		}
+		return "(" + strings.Join(alternatives, " OR ") + ")"
+	}
+	switch requirement.Operator {
+	case model.LabelEquals:
+		return contains(requirement.Values[0])
+	case model.LabelNotEquals:
+		return "NOT " + contains(requirement.Values[0])
+	case model.LabelIn:
+		return containsAny()
+	case model.LabelNotIn:
+		return "NOT " + containsAny()
+	case model.LabelDoesNotExist:
+		return "NOT labels ? " + arg(requirement.Key)
+	default:
+		return "labels ? " + arg(requirement.Key)
+	}
+}
+
+// pgWriteError replaces the unique violation of the VIN index with model.ErrDuplicateVIN.
+func pgWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "car_vin_idx" {
+		return model.ErrDuplicateVIN
+	}
+	return err
+}
+
+// inTx runs fn in a transaction which is committed if fn succeeds and rolled back otherwise.
+func (p *PgRepository) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error in method p.pool.Begin(): %w", err)
+	}
+	defer func() {
+		errRollback := tx.Rollback(context.Background())
+		if errRollback != nil && !errors.Is(errRollback, pgx.ErrTxClosed) {
+			fmt.Printf("PgRepository: Failed to roll back transaction: %v", errRollback)
+		}
+	}()
+	err = fn(tx)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("error in method tx.Commit(): %w", err)
+	}
+	return nil
+}
+
+// Restore clears the deletion mark of a car record and returns the restored car.
+func (p *PgRepository) Restore(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	var car model.Car
+	err := scanCar(p.pool.QueryRow(ctx, "UPDATE car SET deletedat = NULL, version = version + 1 WHERE id = $1 AND deletedat IS NOT NULL RETURNING "+carColumns, id), &car)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PgRepository-Restore: %w", model.ErrCarNotFound)
+		}
+		return nil, fmt.Errorf("PgRepository-Restore: error in method r.pool.QueryRow(): %w", err)
+	}
+	return &car, nil
+}
+
+// Purge removes the car records which were marked as deleted before the given time and returns their number.
+func (p *PgRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	res, err := p.pool.Exec(ctx, "DELETE FROM car WHERE deletedat < $1", deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("PgRepository-Purge: error in method r.pool.Exec(): %w", err)
+	}
+	return res.RowsAffected(), nil
+}
+
+// GetAll retrieves a page of car records matching the filter and returns the token of the next page.
+func (p *PgRepository) GetAll(ctx context.Context, filter *model.CarFilter) ([]*model.Car, string, error) {
 	var cars []*model.Car
-	rows, err := p.pool.Query(ctx, "SELECT id, brand, productionyear, isrunning FROM car")
+	err := p.Stream(ctx, filter, func(car *model.Car) error {
+		cars = append(cars, car)
+		return nil
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("PgRepository-GetAll: %w", err)
+	}
+	return carPage(filter, cars)
+}
+
+// Stream reads the car records matching the filter and passes each of them to send as soon as it is scanned.
+func (p *PgRepository) Stream(ctx context.Context, filter *model.CarFilter, send func(car *model.Car) error) error {
+	cursor, err := decodeCarCursor(filter)
+	if err != nil {
+		return fmt.Errorf("PgRepository-Stream: %w", err)
+	}
+	conditions, args := pgCarQuery(filter, cursor)
+	rows, err := p.pool.Query(ctx, "SELECT "+carColumns+" FROM car"+conditions, args...)
 	if err != nil {
-		return nil, fmt.Errorf("PgRepository-GetAll: error in method r.pool.Query(): %w", err)
+		return fmt.Errorf("PgRepository-Stream: error in method r.pool.Query(): %w", err)
 	}
 	defer rows.Close()
 	for rows.Next() {
 		var car model.Car
-		err := rows.Scan(&car.ID, &car.Brand, &car.ProductionYear, &car.IsRunning)
+		err := scanCar(rows, &car)
 		if err != nil {
-			return nil, fmt.Errorf("PgRepository-GetAll: error in method rows.Scan(): %w", err)
+			return fmt.Errorf("PgRepository-Stream: error in method rows.Scan(): %w", err)
+		}
+		err = send(&car)
+		if err != nil {
+			return fmt.Errorf("PgRepository-Stream: error in method send(): %w", err)
 		}
-		cars = append(cars, &car)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("PgRepository-GetAll: error iterating rows: %w", err)
+		return fmt.Errorf("PgRepository-Stream: error iterating rows: %w", err)
 	}
-	return cars, nil
+	return nil
+}
+
+// pgCarQuery builds the WHERE, ORDER BY and LIMIT clauses that select cars by the filter, the page size of 0 means no limit.
+func pgCarQuery(filter *model.CarFilter, cursor *carCursor) (string, []interface{}) {
+	conditions := []string{"deletedat IS NULL"}
+	var args []interface{}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.OwnerID != uuid.Nil {
+		conditions = append(conditions, "ownerid = "+arg(filter.OwnerID))
+	}
+	if filter.Brand != "" {
+		conditions = append(conditions, "brand = "+arg(filter.Brand))
+	}
+	if filter.MinProductionYear != 0 {
+		conditions = append(conditions, "productionyear >= "+arg(filter.MinProductionYear))
+	}
+	if filter.MaxProductionYear != 0 {
+		conditions = append(conditions, "productionyear <= "+arg(filter.MaxProductionYear))
+	}
+	if filter.IsRunning != nil {
+		conditions = append(conditions, "isrunning = "+arg(*filter.IsRunning))
+	}
+	for _, requirement := range filter.LabelSelector {
+		conditions = append(conditions, pgLabelCondition(requirement, arg))
+	}
+	order, comparison := "ASC", ">"
+	if filter.Descending {
+		order, comparison = "DESC", "<"
+	}
+	orderBy := "id " + order
+	switch filter.SortBy {
+	case model.SortByBrand:
+		orderBy = fmt.Sprintf("brand %s, id %s", order, order)
+		if cursor != nil {
+			conditions = append(conditions, fmt.Sprintf("(brand, id) %s (%s, %s)", comparison, arg(cursor.Brand), arg(cursor.ID)))
+		}
+	case model.SortByProductionYear:
+		orderBy = fmt.Sprintf("productionyear %s, id %s", order, order)
+		if cursor != nil {
+			conditions = append(conditions, fmt.Sprintf("(productionyear, id) %s (%s, %s)", comparison, arg(cursor.ProductionYear), arg(cursor.ID)))
+		}
+	default:
+		if cursor != nil {
+			conditions = append(conditions, fmt.Sprintf("id %s %s", comparison, arg(cursor.ID)))
+		}
+	}
+	query := " WHERE " + strings.Join(conditions, " AND ") + " ORDER BY " + orderBy
+	if filter.PageSize > 0 {
+		query += " LIMIT " + arg(filter.PageSize+1)
+	}
+	return query, args
 }
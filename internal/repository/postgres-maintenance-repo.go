@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// maintenanceColumns are the columns of a maintenance record in the order scanMaintenance reads them.
+const maintenanceColumns = "id, carid, kind, performedat, mileage, cost, notes, critical, closedat, stoppedcar"
+
+// scanMaintenance reads a maintenance record selected with maintenanceColumns.
+func scanMaintenance(row pgx.Row, record *model.MaintenanceRecord) error {
+	return row.Scan(&record.ID, &record.CarID, &record.Kind, &record.PerformedAt, &record.Mileage, &record.Cost,
+		&record.Notes, &record.Critical, &record.ClosedAt, &record.StoppedCar)
+}
+
+// AddMaintenance inserts a maintenance record of a car into the database. An open critical record stops the running car
+// in the same transaction, the stopped car is returned then and nil is returned if the car isn't changed.
+func (p *PgRepository) AddMaintenance(ctx context.Context, record *model.MaintenanceRecord) (*model.Car, error) {
+	var stopped *model.Car
+	err := p.inTx(ctx, func(tx pgx.Tx) error {
+		running, err := lockCarRunning(ctx, tx, record.CarID)
+		if err != nil {
+			return err
+		}
+		record.StoppedCar = record.Critical && record.ClosedAt == nil && running
+		_, err = tx.Exec(ctx, "INSERT INTO maintenance ("+maintenanceColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+			record.ID, record.CarID, string(record.Kind), record.PerformedAt, record.Mileage, record.Cost, record.Notes, record.Critical,
+			record.ClosedAt, record.StoppedCar)
+		if err != nil {
+			return fmt.Errorf("error in method tx.Exec(): %w", err)
+		}
+		if record.StoppedCar {
+			stopped, err = setCarRunning(ctx, tx, record.CarID, false)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-AddMaintenance: %w", err)
+	}
+	return stopped, nil
+}
+
+// lockCarRunning locks the car which is not deleted until the end of the transaction and returns its running state,
+// the maintenance records of a car are changed by one transaction at a time.
+func lockCarRunning(ctx context.Context, tx pgx.Tx, carID uuid.UUID) (bool, error) {
+	var running bool
+	err := tx.QueryRow(ctx, "SELECT isrunning FROM car WHERE id = $1 AND deletedat IS NULL FOR UPDATE", carID).Scan(&running)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, model.ErrCarNotFound
+		}
+		return false, fmt.Errorf("error in method tx.QueryRow(): %w", err)
+	}
+	return running, nil
+}
+
+// setCarRunning changes the running state of the car locked by lockCarRunning, increments its version and returns the changed car.
+func setCarRunning(ctx context.Context, tx pgx.Tx, carID uuid.UUID, running bool) (*model.Car, error) {
+	var car model.Car
+	err := scanCar(tx.QueryRow(ctx, "UPDATE car SET isrunning = $2, version = version + 1 WHERE id = $1 RETURNING "+carColumns,
+		carID, running), &car)
+	if err != nil {
+		return nil, fmt.Errorf("error in method tx.QueryRow(): %w", err)
+	}
+	return &car, nil
+}
+
+// GetMaintenance retrieves a maintenance record by its ID.
+func (p *PgRepository) GetMaintenance(ctx context.Context, id uuid.UUID) (*model.MaintenanceRecord, error) {
+	var record model.MaintenanceRecord
+	err := scanMaintenance(p.pool.QueryRow(ctx, "SELECT "+maintenanceColumns+" FROM maintenance WHERE id = $1", id), &record)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PgRepository-GetMaintenance: %w", model.ErrMaintenanceNotFound)
+		}
+		return nil, fmt.Errorf("PgRepository-GetMaintenance: error in method scanMaintenance(): %w", err)
+	}
+	return &record, nil
+}
+
+// ListMaintenance retrieves the maintenance records of a car, the latest work comes first.
+func (p *PgRepository) ListMaintenance(ctx context.Context, carID uuid.UUID) ([]*model.MaintenanceRecord, error) {
+	rows, err := p.pool.Query(ctx, "SELECT "+maintenanceColumns+" FROM maintenance WHERE carid = $1 ORDER BY performedat DESC, id", carID)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-ListMaintenance: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	var records []*model.MaintenanceRecord
+	for rows.Next() {
+		var record model.MaintenanceRecord
+		err = scanMaintenance(rows, &record)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-ListMaintenance: error in method rows.Scan(): %w", err)
+		}
+		records = append(records, &record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-ListMaintenance: error iterating rows: %w", err)
+	}
+	return records, nil
+}
+
+// CloseMaintenance marks an open maintenance record as done and returns the closed record. If the record holds the mark
+// of stopping the car, the mark is passed to another open critical record of the car or, if there is none, the car runs again
+// in the same transaction. The car which runs again is returned too, nil is returned if the car isn't changed.
+func (p *PgRepository) CloseMaintenance(ctx context.Context, id uuid.UUID, closedAt time.Time) (*model.MaintenanceRecord, *model.Car, error) {
+	var record model.MaintenanceRecord
+	var restarted *model.Car
+	err := p.inTx(ctx, func(tx pgx.Tx) error {
+		var carID uuid.UUID
+		err := tx.QueryRow(ctx, "SELECT carid FROM maintenance WHERE id = $1", id).Scan(&carID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return model.ErrMaintenanceNotFound
+			}
+			return fmt.Errorf("error in method tx.QueryRow(): %w", err)
+		}
+		_, err = lockCarRunning(ctx, tx, carID)
+		if err != nil && !errors.Is(err, model.ErrCarNotFound) {
+			return err
+		}
+		carDeleted := err != nil
+		err = scanMaintenance(tx.QueryRow(ctx, "UPDATE maintenance SET closedat = $2 WHERE id = $1 AND closedat IS NULL RETURNING "+maintenanceColumns,
+			id, closedAt), &record)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return model.ErrMaintenanceNotFound
+			}
+			return fmt.Errorf("error in method scanMaintenance(): %w", err)
+		}
+		if !record.StoppedCar || carDeleted {
+			return nil
+		}
+		tag, err := tx.Exec(ctx, `UPDATE maintenance SET stoppedcar = true WHERE id = (SELECT id FROM maintenance
+			WHERE carid = $1 AND critical AND closedat IS NULL ORDER BY performedat, id LIMIT 1)`, carID)
+		if err != nil {
+			return fmt.Errorf("error in method tx.Exec(): %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			restarted, err = setCarRunning(ctx, tx, carID, true)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("PgRepository-CloseMaintenance: %w", err)
+	}
+	return &record, restarted, nil
+}
+
+// CountOpenCritical counts the open critical maintenance records of a car.
+func (p *PgRepository) CountOpenCritical(ctx context.Context, carID uuid.UUID) (int64, error) {
+	var count int64
+	err := p.pool.QueryRow(ctx, "SELECT count(*) FROM maintenance WHERE carid = $1 AND critical AND closedat IS NULL", carID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("PgRepository-CountOpenCritical: error in method p.pool.QueryRow(): %w", err)
+	}
+	return count, nil
+}
+
+// DueForService retrieves the cars which are not deleted and are due for the routine service by the filter,
+// the cars which were never serviced come first, then the ones serviced longest ago.
+func (p *PgRepository) DueForService(ctx context.Context, filter *model.ServiceDueFilter) ([]*model.ServiceDue, error) {
+	query := `SELECT ` + carColumns + `, last.performedat, coalesce(last.servicemileage, 0) FROM car
+		LEFT JOIN LATERAL (SELECT performedat, mileage AS servicemileage FROM maintenance
+			WHERE carid = car.id AND kind = $1 AND closedat IS NOT NULL ORDER BY performedat DESC LIMIT 1) last ON true
+		WHERE deletedat IS NULL AND ($2::uuid IS NULL OR ownerid = $2)
+			AND (last.performedat IS NULL OR last.performedat < $3 OR mileage - last.servicemileage >= $4)
+		ORDER BY last.performedat NULLS FIRST, id`
+	var owner *uuid.UUID
+	if filter.OwnerID != uuid.Nil {
+		owner = &filter.OwnerID
+	}
+	rows, err := p.pool.Query(ctx, query, string(model.MaintenanceService), owner, filter.ServicedBefore, filter.MileageInterval)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-DueForService: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	var due []*model.ServiceDue
+	for rows.Next() {
+		item := &model.ServiceDue{Car: &model.Car{}}
+		err = scanCar(extraColumnsRow{row: rows, extra: []interface{}{&item.LastServicedAt, &item.LastServiceMileage}}, item.Car)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-DueForService: error in method rows.Scan(): %w", err)
+		}
+		due = append(due, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-DueForService: error iterating rows: %w", err)
+	}
+	return due, nil
+}
+
+// extraColumnsRow scans the columns selected after carColumns into extra.
+type extraColumnsRow struct {
+	row   pgx.Row
+	extra []interface{}
+}
+
+func (r extraColumnsRow) Scan(dest ...interface{}) error {
+	return r.row.Scan(append(dest, r.extra...)...)
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// reservationColumns are the columns of a reservation in the order scanReservation reads them.
+const reservationColumns = "id, carid, userid, startsat, endsat, createdat, canceledat"
+
+// scanReservation reads a reservation selected with reservationColumns.
+func scanReservation(row pgx.Row, reservation *model.Reservation) error {
+	return row.Scan(&reservation.ID, &reservation.CarID, &reservation.UserID, &reservation.StartsAt, &reservation.EndsAt,
+		&reservation.CreatedAt, &reservation.CanceledAt)
+}
+
+// CreateReservation inserts a reservation into the database, the exclusion constraint rejects reservations
+// overlapping an active reservation of the car with model.ErrReservationConflict.
+func (p *PgRepository) CreateReservation(ctx context.Context, reservation *model.Reservation) error {
+	_, err := p.pool.Exec(ctx, "INSERT INTO reservation ("+reservationColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		reservation.ID, reservation.CarID, reservation.UserID, reservation.StartsAt, reservation.EndsAt, reservation.CreatedAt, reservation.CanceledAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23P01" && pgErr.ConstraintName == "reservation_no_overlap" {
+			return fmt.Errorf("PgRepository-CreateReservation: %w", model.ErrReservationConflict)
+		}
+		return fmt.Errorf("PgRepository-CreateReservation: error in method p.pool.Exec(): %w", err)
+	}
+	return nil
+}
+
+// GetReservation retrieves a reservation by its ID, canceled reservations included.
+func (p *PgRepository) GetReservation(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	var reservation model.Reservation
+	err := scanReservation(p.pool.QueryRow(ctx, "SELECT "+reservationColumns+" FROM reservation WHERE id = $1", id), &reservation)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PgRepository-GetReservation: %w", model.ErrReservationNotFound)
+		}
+		return nil, fmt.Errorf("PgRepository-GetReservation: error in method scanReservation(): %w", err)
+	}
+	return &reservation, nil
+}
+
+// CancelReservation marks an active reservation as canceled and returns the canceled reservation.
+func (p *PgRepository) CancelReservation(ctx context.Context, id uuid.UUID, canceledAt time.Time) (*model.Reservation, error) {
+	var reservation model.Reservation
+	err := scanReservation(p.pool.QueryRow(ctx, "UPDATE reservation SET canceledat = $2 WHERE id = $1 AND canceledat IS NULL RETURNING "+reservationColumns,
+		id, canceledAt), &reservation)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PgRepository-CancelReservation: %w", model.ErrReservationNotFound)
+		}
+		return nil, fmt.Errorf("PgRepository-CancelReservation: error in method scanReservation(): %w", err)
+	}
+	return &reservation, nil
+}
+
+// ListReservations retrieves the reservations matching the filter in the order of their start.
+func (p *PgRepository) ListReservations(ctx context.Context, filter *model.ReservationFilter) ([]*model.Reservation, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.CarID != uuid.Nil {
+		args = append(args, filter.CarID)
+		conditions = append(conditions, fmt.Sprintf("carid = $%d", len(args)))
+	}
+	if filter.UserID != uuid.Nil {
+		args = append(args, filter.UserID)
+		conditions = append(conditions, fmt.Sprintf("userid = $%d", len(args)))
+	}
+	if !filter.IncludeCanceled {
+		conditions = append(conditions, "canceledat IS NULL")
+	}
+	query := "SELECT " + reservationColumns + " FROM reservation"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	rows, err := p.pool.Query(ctx, query+" ORDER BY startsat, id", args...)
+	if err != nil {
+		return nil, fmt.Errorf("PgRepository-ListReservations: error in method p.pool.Query(): %w", err)
+	}
+	defer rows.Close()
+	var reservations []*model.Reservation
+	for rows.Next() {
+		var reservation model.Reservation
+		err = scanReservation(rows, &reservation)
+		if err != nil {
+			return nil, fmt.Errorf("PgRepository-ListReservations: error in method rows.Scan(): %w", err)
+		}
+		reservations = append(reservations, &reservation)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PgRepository-ListReservations: error iterating rows: %w", err)
+	}
+	return reservations, nil
+}
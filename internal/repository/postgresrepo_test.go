@@ -3,11 +3,12 @@ package repository
 import (
 	"context"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/distuurbia/firstTaskArtyom/internal/model"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,7 +29,7 @@ func TestUpdate(t *testing.T) {
 	testModel.Brand = "UpdatedTestBrand"
 	testModel.ProductionYear--
 	testModel.IsRunning = false
-	err := rpc.Update(context.Background(), &testModel)
+	err := rpc.Update(context.Background(), &testModel, model.UpdatableCarFields())
 	require.NoError(t, err)
 
 	var updCar *model.Car
@@ -40,6 +41,31 @@ func TestUpdate(t *testing.T) {
 	require.Equal(t, testModel.IsRunning, updCar.IsRunning)
 }
 
+func TestUpdatePartial(t *testing.T) {
+	partialCar := model.Car{ID: testModel.ID, Brand: "NotUpdatedBrand", IsRunning: true, Version: testModel.Version}
+	err := rpc.Update(context.Background(), &partialCar, []string{model.CarFieldIsRunning})
+	require.NoError(t, err)
+	testModel.IsRunning = true
+	testModel.Version = partialCar.Version
+	// the fields which weren't updated are read back from the record
+	require.Equal(t, testModel.Brand, partialCar.Brand)
+
+	updCar, err := rpc.Get(context.Background(), testModel.ID)
+	require.NoError(t, err)
+	require.Equal(t, testModel.Brand, updCar.Brand)
+	require.Equal(t, testModel.ProductionYear, updCar.ProductionYear)
+	require.True(t, updCar.IsRunning)
+}
+
+func TestUpdateVersionConflict(t *testing.T) {
+	staleCar := testModel
+	staleCar.Version--
+	err := rpc.Update(context.Background(), &staleCar, model.UpdatableCarFields())
+	var conflict *model.VersionConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, testModel.Version, conflict.CurrentVersion)
+}
+
 func TestGet(t *testing.T) {
 	getCar, err := rpc.Get(context.Background(), testModel.ID)
 	require.NoError(t, err)
@@ -50,17 +76,50 @@ func TestGet(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	err := rpc.Delete(context.Background(), testModel.ID)
+	_, err := rpc.Delete(context.Background(), testModel.ID)
 	require.NoError(t, err)
 
 	var count int
-	err = rpc.pool.QueryRow(context.Background(), "SELECT COUNT(brand) FROM car WHERE id = $1", testModel.ID).Scan(&count)
+	err = rpc.pool.QueryRow(context.Background(), "SELECT COUNT(brand) FROM car WHERE id = $1 AND deletedat IS NULL", testModel.ID).Scan(&count)
 	require.NoError(t, err)
 	require.Equal(t, 0, count)
+
+	_, err = rpc.Get(context.Background(), testModel.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+}
+
+func TestRestoreAndPurge(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: "RestoreBrand", ProductionYear: RandProductionYear(), Version: 1}
+	err := rpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	deleted, err := rpc.Delete(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.NotNil(t, deleted.DeletedAt)
+	require.Equal(t, car.Version+1, deleted.Version)
+
+	restored, err := rpc.Restore(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Nil(t, restored.DeletedAt)
+	require.Equal(t, car.Brand, restored.Brand)
+	_, err = rpc.Get(context.Background(), car.ID)
+	require.NoError(t, err)
+	_, err = rpc.Restore(context.Background(), car.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+
+	_, err = rpc.Delete(context.Background(), car.ID)
+	require.NoError(t, err)
+	purged, err := rpc.Purge(context.Background(), time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, purged)
+	purged, err = rpc.Purge(context.Background(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, purged, int64(1))
+	_, err = rpc.Restore(context.Background(), car.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
 }
 
 func TestGetAll(t *testing.T) {
-	cars, err := rpc.GetAll(context.Background())
+	cars, _, err := rpc.GetAll(context.Background(), &model.CarFilter{PageSize: 1000})
 	require.NoError(t, err)
 
 	var count int
@@ -69,6 +128,54 @@ func TestGetAll(t *testing.T) {
 	require.Equal(t, len(cars), count)
 }
 
+func TestGetAllPages(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		err := rpc.Create(context.Background(), &model.Car{ID: uuid.New(), Brand: "PageBrand", ProductionYear: RandProductionYear()})
+		require.NoError(t, err)
+	}
+	filter := &model.CarFilter{Brand: "PageBrand", SortBy: model.SortByProductionYear, PageSize: 2}
+	var cars []*model.Car
+	for {
+		page, nextPageToken, err := rpc.GetAll(context.Background(), filter)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page), 2)
+		cars = append(cars, page...)
+		if nextPageToken == "" {
+			break
+		}
+		filter.PageToken = nextPageToken
+	}
+	require.Len(t, cars, 5)
+	for i := 1; i < len(cars); i++ {
+		require.LessOrEqual(t, cars[i-1].ProductionYear, cars[i].ProductionYear)
+	}
+}
+
+func TestStream(t *testing.T) {
+	var streamed int
+	err := rpc.Stream(context.Background(), &model.CarFilter{Brand: "PageBrand"}, func(car *model.Car) error {
+		require.Equal(t, "PageBrand", car.Brand)
+		streamed++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, streamed)
+}
+
+func TestStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := rpc.Stream(ctx, &model.CarFilter{Brand: "PageBrand"}, func(car *model.Car) error {
+		cancel()
+		return ctx.Err()
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGetAllBadPageToken(t *testing.T) {
+	_, _, err := rpc.GetAll(context.Background(), &model.CarFilter{PageSize: 10, PageToken: "not a token"})
+	require.ErrorIs(t, err, model.ErrInvalidPageToken)
+}
+
 func TestStrangeData(t *testing.T) {
 	newage, _ := strconv.Atoi("age")
 	var newbool bool
@@ -88,7 +195,7 @@ func TestStrangeData(t *testing.T) {
 	require.Equal(t, strangeCar.ProductionYear, int64(0))
 	require.Equal(t, strangeCar.IsRunning, false)
 
-	err = rpc.Delete(context.Background(), testModel.ID)
+	_, err = rpc.Delete(context.Background(), testModel.ID)
 	require.NoError(t, err)
 }
 
@@ -96,14 +203,398 @@ func TestDeleteByFakeID(t *testing.T) {
 	defer recoveryFunction()
 	var err error
 	testModel.ID, _ = uuid.Parse("Some UUID")
-	err = rpc.Delete(context.Background(), testModel.ID)
-	require.ErrorIs(t, err, pgx.ErrNoRows)
+	_, err = rpc.Delete(context.Background(), testModel.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
 }
 
 func TestNotValidID(t *testing.T) {
 	defer recoveryFunction()
 	var err error
 	testModel.ID, _ = uuid.Parse("1")
-	err = rpc.Update(context.Background(), &testModel)
-	require.ErrorIs(t, err, pgx.ErrNoRows)
+	err = rpc.Update(context.Background(), &testModel, model.UpdatableCarFields())
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+}
+
+func TestCarHistoryPages(t *testing.T) {
+	carID := uuid.New()
+	changedAt := time.Now().UTC().Truncate(time.Millisecond)
+	for i := 0; i < 3; i++ {
+		err := rpc.AddChange(context.Background(), &model.CarChange{
+			ID:        uuid.New(),
+			CarID:     carID,
+			Action:    model.CarUpdated,
+			ChangedAt: changedAt.Add(time.Duration(i) * time.Second),
+			Before:    &model.Car{ID: carID, Brand: "HistoryBrand", ProductionYear: int64(2000 + i)},
+			After:     &model.Car{ID: carID, Brand: "HistoryBrand", ProductionYear: int64(2001 + i)},
+		})
+		require.NoError(t, err)
+	}
+	filter := &model.CarHistoryFilter{CarID: carID, PageSize: 2}
+	changes, nextPageToken, err := rpc.GetHistory(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.NotEmpty(t, nextPageToken)
+	require.Equal(t, int64(2003), changes[0].After.ProductionYear)
+
+	filter.PageToken = nextPageToken
+	changes, nextPageToken, err = rpc.GetHistory(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Empty(t, nextPageToken)
+	require.Equal(t, int64(2000), changes[0].Before.ProductionYear)
+}
+
+func TestBatchCreateRollback(t *testing.T) {
+	car := &model.Car{ID: uuid.New(), Brand: "BatchBrand", ProductionYear: RandProductionYear(), Version: 1}
+	err := rpc.BatchCreate(context.Background(), []*model.Car{car, car})
+	var itemErr *model.BatchItemError
+	require.ErrorAs(t, err, &itemErr)
+	require.Equal(t, 1, itemErr.Index)
+
+	_, err = rpc.Get(context.Background(), car.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+}
+
+func TestBatchUpdateAndDelete(t *testing.T) {
+	cars := []*model.Car{
+		{ID: uuid.New(), Brand: "BatchBrand", ProductionYear: RandProductionYear(), Version: 1},
+		{ID: uuid.New(), Brand: "BatchBrand", ProductionYear: RandProductionYear(), Version: 1},
+	}
+	err := rpc.BatchCreate(context.Background(), cars)
+	require.NoError(t, err)
+
+	stale := *cars[1]
+	stale.Version = 7
+	err = rpc.BatchUpdate(context.Background(), []*model.CarUpdate{
+		{Car: &model.Car{ID: cars[0].ID, Brand: "BatchUpdated", Version: 1}, Fields: []string{model.CarFieldBrand}},
+		{Car: &stale, Fields: []string{model.CarFieldBrand}},
+	})
+	require.ErrorIs(t, err, model.ErrVersionConflict)
+	car, err := rpc.Get(context.Background(), cars[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, "BatchBrand", car.Brand)
+	require.Equal(t, int64(1), car.Version)
+
+	update := &model.CarUpdate{Car: &model.Car{ID: cars[0].ID, Brand: "BatchUpdated", Version: 1}, Fields: []string{model.CarFieldBrand}}
+	err = rpc.BatchUpdate(context.Background(), []*model.CarUpdate{update})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), update.Car.Version)
+	require.Equal(t, cars[0].ProductionYear, update.Car.ProductionYear)
+
+	_, err = rpc.BatchDelete(context.Background(), []uuid.UUID{cars[0].ID, cars[1].ID})
+	require.NoError(t, err)
+	_, err = rpc.Get(context.Background(), cars[1].ID)
+	require.Error(t, err)
+}
+
+func TestExistingIDs(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: "ExistingBrand", ProductionYear: RandProductionYear(), Version: 1}
+	err := rpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+
+	missing := uuid.New()
+	existing, err := rpc.ExistingIDs(context.Background(), []uuid.UUID{car.ID, missing})
+	require.NoError(t, err)
+	require.True(t, existing[car.ID])
+	require.False(t, existing[missing])
+}
+
+func TestGetMany(t *testing.T) {
+	cars := []*model.Car{
+		{ID: uuid.New(), Brand: "ManyBrand", ProductionYear: RandProductionYear(), Version: 1},
+		{ID: uuid.New(), Brand: "ManyBrand", ProductionYear: RandProductionYear(), Version: 1},
+	}
+	err := rpc.BatchCreate(context.Background(), cars)
+	require.NoError(t, err)
+	_, err = rpc.Delete(context.Background(), cars[1].ID)
+	require.NoError(t, err)
+
+	found, err := rpc.GetMany(context.Background(), []uuid.UUID{cars[0].ID, cars[1].ID, uuid.New()})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, cars[0].ProductionYear, found[cars[0].ID].ProductionYear)
+}
+
+func TestGetByVINAndDuplicateVIN(t *testing.T) {
+	vin := "1M8GDM9AXKP042788"
+	car := model.Car{ID: uuid.New(), Brand: "VINBrand", ProductionYear: RandProductionYear(), Version: 1, VIN: vin, Model: "Q7", Mileage: 1000, Color: "black", Price: 990000}
+	err := rpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+
+	found, err := rpc.GetByVIN(context.Background(), vin)
+	require.NoError(t, err)
+	require.Equal(t, car.ID, found.ID)
+	require.Equal(t, car.Model, found.Model)
+	require.Equal(t, car.Mileage, found.Mileage)
+	require.Equal(t, car.Color, found.Color)
+	require.Equal(t, car.Price, found.Price)
+	_, err = rpc.GetByVIN(context.Background(), "2M8GDM9AXKP042788")
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+
+	duplicate := model.Car{ID: uuid.New(), Brand: "VINBrand", ProductionYear: RandProductionYear(), Version: 1, VIN: vin}
+	err = rpc.Create(context.Background(), &duplicate)
+	require.ErrorIs(t, err, model.ErrDuplicateVIN)
+}
+
+func TestStats(t *testing.T) {
+	ownerID := uuid.New()
+	cars := []model.Car{
+		{ID: uuid.New(), Brand: "StatsBrandA", ProductionYear: 2001, IsRunning: true, OwnerID: ownerID, Version: 1},
+		{ID: uuid.New(), Brand: "StatsBrandA", ProductionYear: 2001, IsRunning: false, OwnerID: ownerID, Version: 1},
+		{ID: uuid.New(), Brand: "StatsBrandB", ProductionYear: 2002, IsRunning: true, OwnerID: ownerID, Version: 1},
+	}
+	for i := range cars {
+		err := rpc.Create(context.Background(), &cars[i])
+		require.NoError(t, err)
+	}
+
+	stats, err := rpc.Stats(context.Background(), ownerID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), stats.Total)
+	require.Equal(t, int64(2), stats.Running)
+	require.Equal(t, []*model.BrandCount{{Brand: "StatsBrandA", Count: 2}, {Brand: "StatsBrandB", Count: 1}}, stats.Brands)
+	require.Equal(t, []*model.ProductionYearCount{{Year: 2001, Count: 2}, {Year: 2002, Count: 1}}, stats.ProductionYears)
+}
+
+func TestCarImages(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: "ImageBrand", ProductionYear: RandProductionYear(), Version: 1}
+	err := rpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	var ids []uuid.UUID
+	for i := 0; i < 3; i++ {
+		image := &model.CarImage{ID: uuid.New(), CarID: car.ID, ContentType: "image/png", Size: 10, Checksum: strings.Repeat("a", 64),
+			CreatedAt: time.Now().UTC().Truncate(time.Millisecond)}
+		err = rpc.AddImage(context.Background(), image)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), image.Position)
+		ids = append(ids, image.ID)
+	}
+
+	err = rpc.SetPrimaryImage(context.Background(), car.ID, ids[2])
+	require.NoError(t, err)
+	images, err := rpc.GetImages(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, images, 3)
+	require.Equal(t, []uuid.UUID{ids[2], ids[0], ids[1]}, []uuid.UUID{images[0].ID, images[1].ID, images[2].ID})
+
+	err = rpc.SetPrimaryImage(context.Background(), car.ID, uuid.New())
+	require.ErrorIs(t, err, model.ErrCarImageNotFound)
+}
+
+func TestConcurrentCarImages(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: "ImageBrand", ProductionYear: RandProductionYear(), Version: 1}
+	err := rpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	const uploads = 3
+	errs := make(chan error, uploads)
+	for i := 0; i < uploads; i++ {
+		go func() {
+			errs <- rpc.AddImage(context.Background(), &model.CarImage{ID: uuid.New(), CarID: car.ID, ContentType: "image/png", Size: 10,
+				Checksum: strings.Repeat("a", 64), CreatedAt: time.Now().UTC().Truncate(time.Millisecond)})
+		}()
+	}
+	for i := 0; i < uploads; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	images, err := rpc.GetImages(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Len(t, images, uploads)
+	for i, image := range images {
+		require.Equal(t, int64(i), image.Position)
+	}
+}
+
+func TestMaintenance(t *testing.T) {
+	ownerID := uuid.New()
+	serviced := model.Car{ID: uuid.New(), Brand: "ServiceBrand", ProductionYear: RandProductionYear(), OwnerID: ownerID, Version: 1, Mileage: 20000, IsRunning: true}
+	overdue := model.Car{ID: uuid.New(), Brand: "ServiceBrand", ProductionYear: RandProductionYear(), OwnerID: ownerID, Version: 1, Mileage: 40000}
+	never := model.Car{ID: uuid.New(), Brand: "ServiceBrand", ProductionYear: RandProductionYear(), OwnerID: ownerID, Version: 1}
+	for _, car := range []*model.Car{&serviced, &overdue, &never} {
+		err := rpc.Create(context.Background(), car)
+		require.NoError(t, err)
+	}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	for _, car := range []*model.Car{&serviced, &overdue} {
+		_, err := rpc.AddMaintenance(context.Background(), &model.MaintenanceRecord{ID: uuid.New(), CarID: car.ID, Kind: model.MaintenanceService,
+			PerformedAt: now.Add(-time.Hour), Mileage: 15000, ClosedAt: &now})
+		require.NoError(t, err)
+	}
+	repair := &model.MaintenanceRecord{ID: uuid.New(), CarID: serviced.ID, Kind: model.MaintenanceRepair, PerformedAt: now, Critical: true}
+	stopped, err := rpc.AddMaintenance(context.Background(), repair)
+	require.NoError(t, err)
+	require.True(t, repair.StoppedCar)
+	require.False(t, stopped.IsRunning)
+	require.Equal(t, serviced.Version+1, stopped.Version)
+	inspection := &model.MaintenanceRecord{ID: uuid.New(), CarID: serviced.ID, Kind: model.MaintenanceInspection, PerformedAt: now, Critical: true}
+	stopped, err = rpc.AddMaintenance(context.Background(), inspection)
+	require.NoError(t, err)
+	require.False(t, inspection.StoppedCar)
+	require.Nil(t, stopped)
+
+	open, err := rpc.CountOpenCritical(context.Background(), serviced.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), open)
+	records, err := rpc.ListMaintenance(context.Background(), serviced.ID)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	running := &model.Car{ID: serviced.ID, IsRunning: true, Version: serviced.Version + 1}
+	err = rpc.Update(context.Background(), running, []string{model.CarFieldIsRunning})
+	require.ErrorIs(t, err, model.ErrCarUnderMaintenance)
+
+	closed, restarted, err := rpc.CloseMaintenance(context.Background(), repair.ID, now)
+	require.NoError(t, err)
+	require.NotNil(t, closed.ClosedAt)
+	require.Nil(t, restarted)
+	_, _, err = rpc.CloseMaintenance(context.Background(), repair.ID, now)
+	require.ErrorIs(t, err, model.ErrMaintenanceNotFound)
+	_, restarted, err = rpc.CloseMaintenance(context.Background(), inspection.ID, now)
+	require.NoError(t, err)
+	require.True(t, restarted.IsRunning)
+	require.Equal(t, serviced.Version+2, restarted.Version)
+	open, err = rpc.CountOpenCritical(context.Background(), serviced.ID)
+	require.NoError(t, err)
+	require.Zero(t, open)
+
+	due, err := rpc.DueForService(context.Background(), &model.ServiceDueFilter{
+		OwnerID:         ownerID,
+		ServicedBefore:  now.Add(-24 * time.Hour),
+		MileageInterval: 15000,
+	})
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	require.Equal(t, never.ID, due[0].Car.ID)
+	require.Nil(t, due[0].LastServicedAt)
+	require.Equal(t, overdue.ID, due[1].Car.ID)
+	require.Equal(t, int64(15000), due[1].LastServiceMileage)
+}
+
+func TestReservations(t *testing.T) {
+	userID := uuid.New()
+	car := model.Car{ID: uuid.New(), Brand: "RentBrand", ProductionYear: RandProductionYear(), OwnerID: uuid.New(), Version: 1}
+	err := rpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	startsAt := time.Now().UTC().Truncate(time.Millisecond).Add(24 * time.Hour)
+	first := &model.Reservation{ID: uuid.New(), CarID: car.ID, UserID: userID, StartsAt: startsAt, EndsAt: startsAt.Add(2 * time.Hour), CreatedAt: startsAt}
+	err = rpc.CreateReservation(context.Background(), first)
+	require.NoError(t, err)
+
+	overlapping := &model.Reservation{ID: uuid.New(), CarID: car.ID, UserID: uuid.New(), StartsAt: startsAt.Add(time.Hour),
+		EndsAt: startsAt.Add(3 * time.Hour), CreatedAt: startsAt}
+	err = rpc.CreateReservation(context.Background(), overlapping)
+	require.ErrorIs(t, err, model.ErrReservationConflict)
+	adjacent := &model.Reservation{ID: uuid.New(), CarID: car.ID, UserID: userID, StartsAt: first.EndsAt, EndsAt: first.EndsAt.Add(time.Hour), CreatedAt: startsAt}
+	err = rpc.CreateReservation(context.Background(), adjacent)
+	require.NoError(t, err)
+
+	canceled, err := rpc.CancelReservation(context.Background(), first.ID, startsAt)
+	require.NoError(t, err)
+	require.NotNil(t, canceled.CanceledAt)
+	_, err = rpc.CancelReservation(context.Background(), first.ID, startsAt)
+	require.ErrorIs(t, err, model.ErrReservationNotFound)
+	err = rpc.CreateReservation(context.Background(), overlapping)
+	require.ErrorIs(t, err, model.ErrReservationConflict)
+	overlapping.EndsAt = first.EndsAt
+	err = rpc.CreateReservation(context.Background(), overlapping)
+	require.NoError(t, err)
+
+	reservations, err := rpc.ListReservations(context.Background(), &model.ReservationFilter{UserID: userID})
+	require.NoError(t, err)
+	require.Len(t, reservations, 1)
+	require.Equal(t, adjacent.ID, reservations[0].ID)
+	reservations, err = rpc.ListReservations(context.Background(), &model.ReservationFilter{CarID: car.ID, IncludeCanceled: true})
+	require.NoError(t, err)
+	require.Len(t, reservations, 3)
+	require.Equal(t, first.ID, reservations[0].ID)
+}
+
+func TestLabelSelector(t *testing.T) {
+	fleet := uuid.NewString()
+	minsk := model.Car{ID: uuid.New(), Brand: "LabelBrand", ProductionYear: RandProductionYear(), Version: 1,
+		Labels: map[string]string{"fleet": fleet, "region": "minsk", "pool": "main"}}
+	reserve := model.Car{ID: uuid.New(), Brand: "LabelBrand", ProductionYear: RandProductionYear(), Version: 1,
+		Labels: map[string]string{"fleet": fleet, "region": "minsk", "pool": "reserve"}}
+	brest := model.Car{ID: uuid.New(), Brand: "LabelBrand", ProductionYear: RandProductionYear(), Version: 1,
+		Labels: map[string]string{"fleet": fleet, "region": "brest"}}
+	for _, car := range []*model.Car{&minsk, &reserve, &brest} {
+		err := rpc.Create(context.Background(), car)
+		require.NoError(t, err)
+	}
+	selected := func(selector string) []uuid.UUID {
+		labelSelector, err := model.ParseLabelSelector("fleet=" + fleet + "," + selector)
+		require.NoError(t, err)
+		cars, _, err := rpc.GetAll(context.Background(), &model.CarFilter{LabelSelector: labelSelector, PageSize: 10})
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, 0, len(cars))
+		for _, car := range cars {
+			ids = append(ids, car.ID)
+		}
+		return ids
+	}
+	require.ElementsMatch(t, []uuid.UUID{minsk.ID}, selected("region=minsk,pool!=reserve"))
+	require.ElementsMatch(t, []uuid.UUID{minsk.ID, brest.ID}, selected("pool notin (reserve)"))
+	require.ElementsMatch(t, []uuid.UUID{minsk.ID, reserve.ID, brest.ID}, selected("region in (minsk,brest)"))
+	require.ElementsMatch(t, []uuid.UUID{minsk.ID, reserve.ID}, selected("pool"))
+	require.ElementsMatch(t, []uuid.UUID{brest.ID}, selected("!pool"))
+
+	brest.Labels = nil
+	err := rpc.Update(context.Background(), &brest, []string{model.CarFieldLabels})
+	require.NoError(t, err)
+	car, err := rpc.Get(context.Background(), brest.ID)
+	require.NoError(t, err)
+	require.Nil(t, car.Labels)
+	car, err = rpc.Get(context.Background(), minsk.ID)
+	require.NoError(t, err)
+	require.Equal(t, minsk.Labels, car.Labels)
+}
+
+func TestBrandCatalog(t *testing.T) {
+	suffix := uuid.NewString()[:8]
+	brand := &model.Brand{ID: uuid.New(), Name: "Volkswagen" + suffix, Aliases: []string{"VW" + suffix, " vw" + suffix + " "}}
+	err := rpc.CreateBrand(context.Background(), brand)
+	require.NoError(t, err)
+	err = rpc.CreateBrand(context.Background(), &model.Brand{ID: uuid.New(), Name: "vw" + suffix})
+	require.ErrorIs(t, err, model.ErrDuplicateBrand)
+
+	resolved, err := rpc.ResolveBrand(context.Background(), " VW"+suffix)
+	require.NoError(t, err)
+	require.Equal(t, brand.ID, resolved.ID)
+	require.Equal(t, brand.Name, resolved.Name)
+	_, err = rpc.ResolveBrand(context.Background(), "Unknown"+suffix)
+	require.ErrorIs(t, err, model.ErrUnknownBrand)
+
+	car := model.Car{ID: uuid.New(), Brand: "vw" + suffix, ProductionYear: RandProductionYear(), Version: 1}
+	err = rpc.Create(context.Background(), &car)
+	require.NoError(t, err)
+	moved, err := rpc.SetCarsBrand(context.Background(), car.Brand, brand)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{car.ID}, moved)
+	stored, err := rpc.Get(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Equal(t, brand.Name, stored.Brand)
+	require.Equal(t, brand.ID, stored.BrandID)
+	require.Equal(t, car.Version+1, stored.Version)
+
+	brand.Name = "VolksWagen" + suffix
+	brand.Aliases = []string{"Volks" + suffix}
+	renamed, err := rpc.UpdateBrand(context.Background(), brand)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{car.ID}, renamed)
+	_, err = rpc.ResolveBrand(context.Background(), "VW"+suffix)
+	require.ErrorIs(t, err, model.ErrUnknownBrand)
+	stored, err = rpc.Get(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Equal(t, brand.Name, stored.Brand)
+
+	err = rpc.DeleteBrand(context.Background(), brand.ID)
+	require.ErrorIs(t, err, model.ErrBrandInUse)
+	unused := &model.Brand{ID: uuid.New(), Name: "Unused" + suffix}
+	err = rpc.CreateBrand(context.Background(), unused)
+	require.NoError(t, err)
+	err = rpc.DeleteBrand(context.Background(), unused.ID)
+	require.NoError(t, err)
+	_, err = rpc.GetBrand(context.Background(), unused.ID)
+	require.ErrorIs(t, err, model.ErrBrandNotFound)
+	_, err = rpc.ResolveBrand(context.Background(), unused.Name)
+	require.ErrorIs(t, err, model.ErrUnknownBrand)
 }
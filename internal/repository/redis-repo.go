@@ -3,43 +3,135 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
+	"strings"
+	"time"
 
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
 	"github.com/distuurbia/firstTaskArtyom/internal/model"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
 )
 
+// carNotFoundValue is cached under the key of a car which doesn't exist, it can't be mistaken for the JSON of a car.
+const carNotFoundValue = "notfound"
+
+// releaseLeaseScript removes the lease only if it is still held with the token, an expired lease may be taken by another loader.
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// setCarScript caches the car unless a newer version of it is already cached, so a slow writer of an old car
+// doesn't replace the car cached by a later update. A car replaces the mark of a car which is not found.
+// KEYS[1] is the key of the car and KEYS[2] the key of its VIN if it has one,
+// ARGV holds the JSON of the car, its version, its TTL in milliseconds or 0 to keep it, the mark of a missing car and the ID of the car.
+var setCarScript = redis.NewScript(`
+local cached = redis.call("GET", KEYS[1])
+if cached and cached ~= ARGV[4] then
+	local ok, car = pcall(cjson.decode, cached)
+	if ok and type(car) == "table" and tonumber(car["version"]) and tonumber(car["version"]) > tonumber(ARGV[2]) then
+		return 0
+	end
+end
+local expiry = {}
+if tonumber(ARGV[3]) > 0 then
+	expiry = {"PX", ARGV[3]}
+end
+redis.call("SET", KEYS[1], ARGV[1], unpack(expiry))
+if KEYS[2] then
+	redis.call("SET", KEYS[2], ARGV[5], unpack(expiry))
+end
+return 1`)
+
 // RedisRepository represents the Redis repository implementation.
 type RedisRepository struct {
 	client *redis.Client
+	cfg    *config.Config
 }
 
 // NewRedisRepository creates and returns a new instance of RedisRepository, using the provided redis.Client.
-func NewRedisRepository(client *redis.Client) *RedisRepository {
+// The keys of the cache begin with cfg.CacheKeyPrefix and the cached cars expire after cfg.CarCacheTTL.
+func NewRedisRepository(client *redis.Client, cfg *config.Config) *RedisRepository {
 	return &RedisRepository{
 		client: client,
+		cfg:    cfg,
 	}
 }
 
-// SetCache stores the provided car object in the Redis cache.
+// cacheKey joins the parts of a key of the cache behind the prefix of the cache.
+func (r *RedisRepository) cacheKey(parts ...string) string {
+	return r.cfg.CacheKeyPrefix + ":" + strings.Join(parts, ":")
+}
+
+// carKey is the key of the cached car.
+func (r *RedisRepository) carKey(id uuid.UUID) string {
+	return r.cacheKey("car", id.String())
+}
+
+// carVINKey is the key of the ID of the cached car with the VIN.
+func (r *RedisRepository) carVINKey(vin string) string {
+	return r.cacheKey("carvin", vin)
+}
+
+// carTTL returns how long a car is cached, a random jitter is added so the cars cached at once expire at different times.
+func (r *RedisRepository) carTTL() time.Duration {
+	ttl := r.cfg.CarCacheTTL
+	if r.cfg.CarCacheTTLJitter > 0 {
+		ttl += time.Duration(rand.Int63n(int64(r.cfg.CarCacheTTLJitter)))
+	}
+	return ttl
+}
+
+// SetCache stores the provided car object in the Redis cache until it expires.
 func (r *RedisRepository) SetCache(ctx context.Context, car *model.Car) error {
-	carJSON, err := json.Marshal(car)
+	err := r.SetCacheMany(ctx, []*model.Car{car})
+	if err != nil {
+		return fmt.Errorf("RedisRepository-Set: %w", err)
+	}
+	return nil
+}
+
+// SetCacheMany stores the cars in the Redis cache in one round trip, every car gets its own expiry.
+// A car is skipped if a newer version of it is already cached.
+func (r *RedisRepository) SetCacheMany(ctx context.Context, cars []*model.Car) error {
+	if len(cars) == 0 {
+		return nil
+	}
+	pipe := r.client.Pipeline()
+	for _, car := range cars {
+		carJSON, err := json.Marshal(car)
+		if err != nil {
+			return fmt.Errorf("RedisRepository-SetMany: error in method json.Marshal(): %w", err)
+		}
+		keys := []string{r.carKey(car.ID)}
+		if car.VIN != "" {
+			keys = append(keys, r.carVINKey(car.VIN))
+		}
+		setCarScript.Eval(ctx, pipe, keys, carJSON, car.Version, r.carTTL().Milliseconds(), carNotFoundValue, car.ID.String())
+	}
+	_, err := pipe.Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("RedisRepository-Set: error in method json.Marshal(): %w", err)
+		return fmt.Errorf("RedisRepository-SetMany: error in method pipe.Exec(): %w", err)
 	}
-	r.client.HSet(ctx, "car", car.ID.String(), carJSON)
 	return nil
 }
 
-// GetCache retrieves the car object with the specified ID from the Redis cache.
+// GetCache retrieves the car object with the specified ID from the Redis cache, it returns redis.Nil on a miss
+// and model.ErrCarNotFound if the cache remembers that there is no such car.
 func (r *RedisRepository) GetCache(ctx context.Context, id uuid.UUID) (*model.Car, error) {
-	carJSON, err := r.client.HGet(ctx, "car", id.String()).Result()
+	carJSON, err := r.client.Get(ctx, r.carKey(id)).Result()
 	if err != nil {
 		if err == redis.Nil {
 			return nil, err
 		}
-		return nil, fmt.Errorf("RedisRepository-Get: error in method s.client.HGet(): %w", err)
+		return nil, fmt.Errorf("RedisRepository-Get: error in method r.client.Get(): %w", err)
+	}
+	if carJSON == carNotFoundValue {
+		return nil, fmt.Errorf("RedisRepository-Get: %w", model.ErrCarNotFound)
 	}
 	var car model.Car
 	err = json.Unmarshal([]byte(carJSON), &car)
@@ -49,11 +141,348 @@ func (r *RedisRepository) GetCache(ctx context.Context, id uuid.UUID) (*model.Ca
 	return &car, nil
 }
 
+// GetCacheMany retrieves the cars with the IDs from the Redis cache in one round trip,
+// the cars which are not cached are missing from the result as well as the IDs cached as not found.
+func (r *RedisRepository) GetCacheMany(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error) {
+	cars := make(map[uuid.UUID]*model.Car, len(ids))
+	if len(ids) == 0 {
+		return cars, nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, r.carKey(id))
+	}
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("RedisRepository-GetMany: error in method r.client.MGet(): %w", err)
+	}
+	for _, value := range values {
+		carJSON, ok := value.(string)
+		if !ok || carJSON == carNotFoundValue {
+			continue
+		}
+		var car model.Car
+		err = json.Unmarshal([]byte(carJSON), &car)
+		if err != nil {
+			return nil, fmt.Errorf("RedisRepository-GetMany: error in method json.Unmarshal(): %w", err)
+		}
+		cars[car.ID] = &car
+	}
+	return cars, nil
+}
+
+// GetCacheByVIN retrieves the car object with the specified VIN from the Redis cache, it returns redis.Nil on a miss.
+// The VIN points to the ID of the car, a VIN left behind by a changed or removed car is a miss too.
+func (r *RedisRepository) GetCacheByVIN(ctx context.Context, vin string) (*model.Car, error) {
+	idString, err := r.client.Get(ctx, r.carVINKey(vin)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("RedisRepository-GetByVIN: error in method r.client.Get(): %w", err)
+	}
+	id, err := uuid.Parse(idString)
+	if err != nil {
+		return nil, fmt.Errorf("RedisRepository-GetByVIN: error in method uuid.Parse(): %w", err)
+	}
+	car, err := r.GetCache(ctx, id)
+	if errors.Is(err, model.ErrCarNotFound) {
+		return nil, redis.Nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if car.VIN != vin {
+		return nil, redis.Nil
+	}
+	return car, nil
+}
+
+// SetCacheNotFound remembers for a short time that there is no car with the ID, a car stored later with SetCache replaces the mark.
+// A car which is already cached isn't replaced, it may have been created after the database was read.
+func (r *RedisRepository) SetCacheNotFound(ctx context.Context, id uuid.UUID) error {
+	err := r.client.SetNX(ctx, r.carKey(id), carNotFoundValue, r.cfg.CarNotFoundCacheTTL).Err()
+	if err != nil {
+		return fmt.Errorf("RedisRepository-SetNotFound: error in method r.client.SetNX(): %w", err)
+	}
+	return nil
+}
+
+// carLoadLeaseKey is the key of the lease of the instance loading the missed car into the cache.
+func (r *RedisRepository) carLoadLeaseKey(id uuid.UUID) string {
+	return r.cacheKey("carload", id.String())
+}
+
+// AcquireCarLoadLease takes the lease to load the missed car into the cache for cfg.CarLoadLeaseTTL and returns its token,
+// an empty token means another loader holds the lease.
+func (r *RedisRepository) AcquireCarLoadLease(ctx context.Context, id uuid.UUID) (string, error) {
+	token := uuid.NewString()
+	acquired, err := r.client.SetNX(ctx, r.carLoadLeaseKey(id), token, r.cfg.CarLoadLeaseTTL).Result()
+	if err != nil {
+		return "", fmt.Errorf("RedisRepository-AcquireCarLoadLease: error in method r.client.SetNX(): %w", err)
+	}
+	if !acquired {
+		return "", nil
+	}
+	return token, nil
+}
+
+// ReleaseCarLoadLease gives back the lease taken with the token, so the next miss doesn't wait for it to expire.
+func (r *RedisRepository) ReleaseCarLoadLease(ctx context.Context, id uuid.UUID, token string) error {
+	err := releaseLeaseScript.Run(ctx, r.client, []string{r.carLoadLeaseKey(id)}, token).Err()
+	if err != nil {
+		return fmt.Errorf("RedisRepository-ReleaseCarLoadLease: error in method releaseLeaseScript.Run(): %w", err)
+	}
+	return nil
+}
+
 // DeleteCache removes the car object with the specified ID from the Redis cache.
+// The key of its VIN is left to expire, GetCacheByVIN doesn't find the car through it any more.
 func (r *RedisRepository) DeleteCache(ctx context.Context, id uuid.UUID) error {
-	_, err := r.client.HDel(ctx, "car", id.String()).Result()
+	err := r.client.Del(ctx, r.carKey(id)).Err()
 	if err != nil {
-		return fmt.Errorf("RedisRepository-Delete: error in method s.client.HDel(): %w", err)
+		return fmt.Errorf("RedisRepository-Delete: error in method r.client.Del(): %w", err)
 	}
 	return nil
 }
+
+// DeleteCacheMany removes the cars with the IDs from the Redis cache in one round trip.
+func (r *RedisRepository) DeleteCacheMany(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, r.carKey(id))
+	}
+	err := r.client.Del(ctx, keys...).Err()
+	if err != nil {
+		return fmt.Errorf("RedisRepository-DeleteMany: error in method r.client.Del(): %w", err)
+	}
+	return nil
+}
+
+// CachedCarIDs returns the IDs of a part of the cached cars, the IDs cached as not found included, and the cursor of the next part.
+// The scan starts with cursor 0 and is over when the returned cursor is 0, about count keys are looked at in one call.
+func (r *RedisRepository) CachedCarIDs(ctx context.Context, cursor uint64, count int64) ([]uuid.UUID, uint64, error) {
+	keyPrefix := r.cacheKey("car", "")
+	keys, next, err := r.client.Scan(ctx, cursor, keyPrefix+"*", count).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("RedisRepository-CachedCarIDs: error in method r.client.Scan(): %w", err)
+	}
+	ids := make([]uuid.UUID, 0, len(keys))
+	for _, key := range keys {
+		id, err := uuid.Parse(strings.TrimPrefix(key, keyPrefix))
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, next, nil
+}
+
+// carStatsKey is the key of the cached statistics of the cars of the owner, uuid.Nil stands for every owner.
+func (r *RedisRepository) carStatsKey(ownerID uuid.UUID) string {
+	return r.cacheKey("carstats", ownerID.String())
+}
+
+// SetStatsCache stores the statistics of the cars of the owner in the Redis cache for the given time.
+func (r *RedisRepository) SetStatsCache(ctx context.Context, ownerID uuid.UUID, stats *model.CarStats, ttl time.Duration) error {
+	statsJSON, err := json.Marshal(stats)
+	if err != nil {
+		return fmt.Errorf("RedisRepository-SetStats: error in method json.Marshal(): %w", err)
+	}
+	err = r.client.Set(ctx, r.carStatsKey(ownerID), statsJSON, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("RedisRepository-SetStats: error in method r.client.Set(): %w", err)
+	}
+	return nil
+}
+
+// GetStatsCache retrieves the statistics of the cars of the owner from the Redis cache, it returns redis.Nil on a miss.
+func (r *RedisRepository) GetStatsCache(ctx context.Context, ownerID uuid.UUID) (*model.CarStats, error) {
+	statsJSON, err := r.client.Get(ctx, r.carStatsKey(ownerID)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("RedisRepository-GetStats: error in method r.client.Get(): %w", err)
+	}
+	var stats model.CarStats
+	err = json.Unmarshal([]byte(statsJSON), &stats)
+	if err != nil {
+		return nil, fmt.Errorf("RedisRepository-GetStats: error in method json.Unmarshal(): %w", err)
+	}
+	return &stats, nil
+}
+
+// idempotencyKey is the key of the stored response to the calls with the idempotency key.
+func (r *RedisRepository) idempotencyKey(key string) string {
+	return r.cacheKey("idempotency", key)
+}
+
+// ReserveIdempotencyKey stores the hash of the request under the idempotency key for the given time if the key is new,
+// otherwise it returns what is already stored under the key. A nil response means the key is reserved by this call.
+func (r *RedisRepository) ReserveIdempotencyKey(ctx context.Context, key, requestHash string, ttl time.Duration) (*model.IdempotentResponse, error) {
+	reservedJSON, err := json.Marshal(&model.IdempotentResponse{RequestHash: requestHash})
+	if err != nil {
+		return nil, fmt.Errorf("RedisRepository-ReserveIdempotencyKey: error in method json.Marshal(): %w", err)
+	}
+	for {
+		reserved, err := r.client.SetNX(ctx, r.idempotencyKey(key), reservedJSON, ttl).Result()
+		if err != nil {
+			return nil, fmt.Errorf("RedisRepository-ReserveIdempotencyKey: error in method r.client.SetNX(): %w", err)
+		}
+		if reserved {
+			return nil, nil
+		}
+		storedJSON, err := r.client.Get(ctx, r.idempotencyKey(key)).Result()
+		if err == redis.Nil {
+			// the key has expired in between, it can be reserved again
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("RedisRepository-ReserveIdempotencyKey: error in method r.client.Get(): %w", err)
+		}
+		var stored model.IdempotentResponse
+		err = json.Unmarshal([]byte(storedJSON), &stored)
+		if err != nil {
+			return nil, fmt.Errorf("RedisRepository-ReserveIdempotencyKey: error in method json.Unmarshal(): %w", err)
+		}
+		return &stored, nil
+	}
+}
+
+// SaveIdempotentResponse stores the response to the call which has reserved the idempotency key for the given time.
+func (r *RedisRepository) SaveIdempotentResponse(ctx context.Context, key string, response *model.IdempotentResponse, ttl time.Duration) error {
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("RedisRepository-SaveIdempotentResponse: error in method json.Marshal(): %w", err)
+	}
+	err = r.client.Set(ctx, r.idempotencyKey(key), responseJSON, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("RedisRepository-SaveIdempotentResponse: error in method r.client.Set(): %w", err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey removes the idempotency key, so the call can be retried after it has failed.
+func (r *RedisRepository) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	err := r.client.Del(ctx, r.idempotencyKey(key)).Err()
+	if err != nil {
+		return fmt.Errorf("RedisRepository-ReleaseIdempotencyKey: error in method r.client.Del(): %w", err)
+	}
+	return nil
+}
+
+// carEventsChannel is the Redis channel the changes of cars are published to.
+func (r *RedisRepository) carEventsChannel() string {
+	return r.cacheKey("carevents")
+}
+
+// Publish sends the change of a car to every subscriber, on every server instance.
+func (r *RedisRepository) Publish(ctx context.Context, change *model.CarChange) error {
+	changeJSON, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("RedisRepository-Publish: error in method json.Marshal(): %w", err)
+	}
+	err = r.client.Publish(ctx, r.carEventsChannel(), changeJSON).Err()
+	if err != nil {
+		return fmt.Errorf("RedisRepository-Publish: error in method r.client.Publish(): %w", err)
+	}
+	return nil
+}
+
+// Subscribe passes every published change of a car to send until ctx is done or send fails.
+func (r *RedisRepository) Subscribe(ctx context.Context, send func(change *model.CarChange) error) error {
+	pubsub := r.client.Subscribe(ctx, r.carEventsChannel())
+	defer func() {
+		errClose := pubsub.Close()
+		if errClose != nil {
+			fmt.Printf("RedisRepository-Subscribe: Failed to close subscription: %v", errClose)
+		}
+	}()
+	_, err := pubsub.Receive(ctx)
+	if err != nil {
+		return fmt.Errorf("RedisRepository-Subscribe: error in method pubsub.Receive(): %w", err)
+	}
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-messages:
+			if !ok {
+				return fmt.Errorf("RedisRepository-Subscribe: subscription is closed")
+			}
+			var change model.CarChange
+			err = json.Unmarshal([]byte(msg.Payload), &change)
+			if err != nil {
+				return fmt.Errorf("RedisRepository-Subscribe: error in method json.Unmarshal(): %w", err)
+			}
+			err = send(&change)
+			if err != nil {
+				return fmt.Errorf("RedisRepository-Subscribe: error in method send(): %w", err)
+			}
+		}
+	}
+}
+
+// cacheInvalidation is the message telling the other instances to drop the cars from their local caches.
+type cacheInvalidation struct {
+	// Source is the instance which has sent the message, it has already dropped the cars.
+	Source string      `json:"source"`
+	IDs    []uuid.UUID `json:"ids"`
+}
+
+// cacheInvalidationChannel is the Redis channel the invalidations of the local caches are published to.
+func (r *RedisRepository) cacheInvalidationChannel() string {
+	return r.cacheKey("carinvalidation")
+}
+
+// PublishCacheInvalidation tells every instance except source to drop the cars from its local cache.
+func (r *RedisRepository) PublishCacheInvalidation(ctx context.Context, source string, ids []uuid.UUID) error {
+	messageJSON, err := json.Marshal(&cacheInvalidation{Source: source, IDs: ids})
+	if err != nil {
+		return fmt.Errorf("RedisRepository-PublishCacheInvalidation: error in method json.Marshal(): %w", err)
+	}
+	err = r.client.Publish(ctx, r.cacheInvalidationChannel(), messageJSON).Err()
+	if err != nil {
+		return fmt.Errorf("RedisRepository-PublishCacheInvalidation: error in method r.client.Publish(): %w", err)
+	}
+	return nil
+}
+
+// SubscribeCacheInvalidations passes the published invalidations to handle until ctx is done or the subscription fails.
+// The subscription is set up when ready is called, the invalidations published before may be missed.
+func (r *RedisRepository) SubscribeCacheInvalidations(ctx context.Context, ready func(), handle func(source string, ids []uuid.UUID)) error {
+	pubsub := r.client.Subscribe(ctx, r.cacheInvalidationChannel())
+	defer func() {
+		errClose := pubsub.Close()
+		if errClose != nil {
+			fmt.Printf("RedisRepository-SubscribeCacheInvalidations: Failed to close subscription: %v", errClose)
+		}
+	}()
+	_, err := pubsub.Receive(ctx)
+	if err != nil {
+		return fmt.Errorf("RedisRepository-SubscribeCacheInvalidations: error in method pubsub.Receive(): %w", err)
+	}
+	ready()
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-messages:
+			if !ok {
+				return fmt.Errorf("RedisRepository-SubscribeCacheInvalidations: subscription is closed")
+			}
+			var invalidation cacheInvalidation
+			err = json.Unmarshal([]byte(msg.Payload), &invalidation)
+			if err != nil {
+				return fmt.Errorf("RedisRepository-SubscribeCacheInvalidations: error in method json.Unmarshal(): %w", err)
+			}
+			handle(invalidation.Source, invalidation.IDs)
+		}
+	}
+}
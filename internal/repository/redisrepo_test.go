@@ -2,8 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,3 +30,366 @@ func TestDeleteCache(t *testing.T) {
 	err := rdsRps.DeleteCache(context.Background(), testModel.ID)
 	require.NoError(t, err)
 }
+
+func TestCacheExpires(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: RandBrand(), ProductionYear: RandProductionYear()}
+	err := rdsRps.SetCache(context.Background(), &car)
+	require.NoError(t, err)
+	ttl, err := rdsRps.client.TTL(context.Background(), "test:car:"+car.ID.String()).Result()
+	require.NoError(t, err)
+	require.Greater(t, ttl, time.Duration(0))
+	require.LessOrEqual(t, ttl, testConfig.CarCacheTTL+testConfig.CarCacheTTLJitter)
+}
+
+func TestCacheMany(t *testing.T) {
+	cars := []*model.Car{
+		{ID: uuid.New(), Brand: RandBrand(), ProductionYear: RandProductionYear()},
+		{ID: uuid.New(), Brand: RandBrand(), ProductionYear: RandProductionYear(), VIN: "2HGFG12648H542210"},
+	}
+	err := rdsRps.SetCacheMany(context.Background(), cars)
+	require.NoError(t, err)
+	missing := uuid.New()
+	cached, err := rdsRps.GetCacheMany(context.Background(), []uuid.UUID{cars[0].ID, missing, cars[1].ID})
+	require.NoError(t, err)
+	require.Len(t, cached, 2)
+	require.Equal(t, cars[0].Brand, cached[cars[0].ID].Brand)
+	require.Equal(t, cars[1].VIN, cached[cars[1].ID].VIN)
+	require.NotContains(t, cached, missing)
+
+	err = rdsRps.DeleteCache(context.Background(), cars[1].ID)
+	require.NoError(t, err)
+	_, err = rdsRps.GetCacheByVIN(context.Background(), cars[1].VIN)
+	require.ErrorIs(t, err, redis.Nil)
+}
+
+func TestCacheNotFound(t *testing.T) {
+	id := uuid.New()
+	err := rdsRps.SetCacheNotFound(context.Background(), id)
+	require.NoError(t, err)
+	_, err = rdsRps.GetCache(context.Background(), id)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+	cached, err := rdsRps.GetCacheMany(context.Background(), []uuid.UUID{id})
+	require.NoError(t, err)
+	require.Empty(t, cached)
+
+	car := model.Car{ID: id, Brand: RandBrand(), ProductionYear: RandProductionYear()}
+	err = rdsRps.SetCache(context.Background(), &car)
+	require.NoError(t, err)
+	getCar, err := rdsRps.GetCache(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, car.Brand, getCar.Brand)
+
+	// a car created after the database was read isn't replaced by the mark
+	err = rdsRps.SetCacheNotFound(context.Background(), id)
+	require.NoError(t, err)
+	_, err = rdsRps.GetCache(context.Background(), id)
+	require.NoError(t, err)
+}
+
+func TestCacheKeepsNewerCar(t *testing.T) {
+	newer := model.Car{ID: uuid.New(), Brand: "Newer", ProductionYear: RandProductionYear(), VIN: "5YJSA1E26HF000337", Version: 3}
+	older := newer
+	older.Brand = "Older"
+	older.Version = 2
+	err := rdsRps.SetCache(context.Background(), &newer)
+	require.NoError(t, err)
+	err = rdsRps.SetCache(context.Background(), &older)
+	require.NoError(t, err)
+	getCar, err := rdsRps.GetCache(context.Background(), newer.ID)
+	require.NoError(t, err)
+	require.Equal(t, newer.Brand, getCar.Brand)
+	getCar, err = rdsRps.GetCacheByVIN(context.Background(), newer.VIN)
+	require.NoError(t, err)
+	require.Equal(t, newer.Version, getCar.Version)
+
+	latest := newer
+	latest.Brand = "Latest"
+	latest.Version = 4
+	err = rdsRps.SetCacheMany(context.Background(), []*model.Car{&latest})
+	require.NoError(t, err)
+	getCar, err = rdsRps.GetCache(context.Background(), newer.ID)
+	require.NoError(t, err)
+	require.Equal(t, latest.Brand, getCar.Brand)
+	ttl, err := rdsRps.client.TTL(context.Background(), rdsRps.carKey(newer.ID)).Result()
+	require.NoError(t, err)
+	require.Positive(t, ttl)
+}
+
+func TestCarLoadLease(t *testing.T) {
+	id := uuid.New()
+	token, err := rdsRps.AcquireCarLoadLease(context.Background(), id)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	other, err := rdsRps.AcquireCarLoadLease(context.Background(), id)
+	require.NoError(t, err)
+	require.Empty(t, other)
+
+	err = rdsRps.ReleaseCarLoadLease(context.Background(), id, "stale")
+	require.NoError(t, err)
+	other, err = rdsRps.AcquireCarLoadLease(context.Background(), id)
+	require.NoError(t, err)
+	require.Empty(t, other)
+	err = rdsRps.ReleaseCarLoadLease(context.Background(), id, token)
+	require.NoError(t, err)
+	other, err = rdsRps.AcquireCarLoadLease(context.Background(), id)
+	require.NoError(t, err)
+	require.NotEmpty(t, other)
+}
+
+func TestGetCacheByVIN(t *testing.T) {
+	car := testModel
+	car.VIN = "1M8GDM9AXKP042788"
+	err := rdsRps.SetCache(context.Background(), &car)
+	require.NoError(t, err)
+
+	getCar, err := rdsRps.GetCacheByVIN(context.Background(), car.VIN)
+	require.NoError(t, err)
+	require.Equal(t, car.ID, getCar.ID)
+
+	car.VIN = "11111111111111111"
+	err = rdsRps.SetCache(context.Background(), &car)
+	require.NoError(t, err)
+	_, err = rdsRps.GetCacheByVIN(context.Background(), "1M8GDM9AXKP042788")
+	require.ErrorIs(t, err, redis.Nil)
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	change := &model.CarChange{ID: uuid.New(), CarID: testModel.ID, Action: model.CarCreated, After: &testModel}
+	errStop := errors.New("received")
+	received := make(chan *model.CarChange, 1)
+	errSubscribe := make(chan error, 1)
+	go func() {
+		errSubscribe <- rdsRps.Subscribe(context.Background(), func(got *model.CarChange) error {
+			received <- got
+			return errStop
+		})
+	}()
+
+	// the change is published until the subscription is set up and receives it
+	require.Eventually(t, func() bool {
+		require.NoError(t, rdsRps.Publish(context.Background(), change))
+		select {
+		case got := <-received:
+			require.Equal(t, change.ID, got.ID)
+			require.Equal(t, change.Action, got.Action)
+			require.Equal(t, testModel.Brand, got.After.Brand)
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, <-errSubscribe, errStop)
+}
+
+func TestStatsCache(t *testing.T) {
+	ownerID := uuid.New()
+	_, err := rdsRps.GetStatsCache(context.Background(), ownerID)
+	require.ErrorIs(t, err, redis.Nil)
+
+	stats := &model.CarStats{Total: 2, Running: 1, Brands: []*model.BrandCount{{Brand: testModel.Brand, Count: 2}}}
+	err = rdsRps.SetStatsCache(context.Background(), ownerID, stats, time.Minute)
+	require.NoError(t, err)
+	getStats, err := rdsRps.GetStatsCache(context.Background(), ownerID)
+	require.NoError(t, err)
+	require.Equal(t, stats, getStats)
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	key := uuid.NewString()
+	stored, err := rdsRps.ReserveIdempotencyKey(context.Background(), key, "hash", time.Minute)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+	exists, err := rdsRps.client.Exists(context.Background(), testConfig.CacheKeyPrefix+":idempotency:"+key).Result()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), exists)
+	stored, err = rdsRps.ReserveIdempotencyKey(context.Background(), key, "other", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "hash", stored.RequestHash)
+	require.Empty(t, stored.Response)
+
+	err = rdsRps.SaveIdempotentResponse(context.Background(), key, &model.IdempotentResponse{RequestHash: "hash", Response: []byte("response")}, time.Minute)
+	require.NoError(t, err)
+	stored, err = rdsRps.ReserveIdempotencyKey(context.Background(), key, "hash", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, []byte("response"), stored.Response)
+
+	err = rdsRps.ReleaseIdempotencyKey(context.Background(), key)
+	require.NoError(t, err)
+	stored, err = rdsRps.ReserveIdempotencyKey(context.Background(), key, "other", time.Minute)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+}
+
+func TestCarLRU(t *testing.T) {
+	lru := newCarLRU(2, time.Minute)
+	first := &model.Car{ID: uuid.New(), Brand: "First"}
+	second := &model.Car{ID: uuid.New(), Brand: "Second"}
+	third := &model.Car{ID: uuid.New(), Brand: "Third"}
+	lru.put(first)
+	lru.put(second)
+	_, ok := lru.get(first.ID)
+	require.True(t, ok)
+	lru.put(third)
+	_, ok = lru.get(second.ID)
+	require.False(t, ok)
+	car, ok := lru.get(first.ID)
+	require.True(t, ok)
+	car.Brand = "Changed"
+	car, ok = lru.get(first.ID)
+	require.True(t, ok)
+	require.Equal(t, "First", car.Brand)
+
+	newer := &model.Car{ID: first.ID, Brand: "Newer", Version: 2}
+	require.True(t, lru.put(newer))
+	require.False(t, lru.put(first))
+	car, ok = lru.get(first.ID)
+	require.True(t, ok)
+	require.Equal(t, newer.Brand, car.Brand)
+
+	expiring := newCarLRU(2, time.Millisecond)
+	expiring.put(first)
+	time.Sleep(5 * time.Millisecond)
+	_, ok = expiring.get(first.ID)
+	require.False(t, ok)
+}
+
+func TestTieredCarCacheInvalidation(t *testing.T) {
+	local := NewTieredCarCache(rdsRps, &testConfig)
+	remote := NewTieredCarCache(rdsRps, &testConfig)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go remote.ListenInvalidations(ctx)
+
+	car := &model.Car{ID: uuid.New(), Brand: RandBrand(), ProductionYear: RandProductionYear()}
+	err := local.SetCache(context.Background(), car)
+	require.NoError(t, err)
+	getCar, err := remote.GetCache(context.Background(), car.ID)
+	require.NoError(t, err)
+	require.Equal(t, car.Brand, getCar.Brand)
+
+	// the car is deleted until the subscription of the remote instance is set up and drops it
+	require.Eventually(t, func() bool {
+		remote.local.put(car)
+		require.NoError(t, local.DeleteCache(context.Background(), car.ID))
+		time.Sleep(20 * time.Millisecond)
+		_, ok := remote.local.get(car.ID)
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = remote.GetCache(context.Background(), car.ID)
+	require.ErrorIs(t, err, redis.Nil)
+
+	// a newer car cached by one instance replaces the local copies of the others
+	require.NoError(t, local.SetCache(context.Background(), car))
+	_, err = remote.GetCache(context.Background(), car.ID)
+	require.NoError(t, err)
+	updated := *car
+	updated.Brand = "UpdatedBrand"
+	updated.Version++
+	require.NoError(t, local.SetCache(context.Background(), &updated))
+	require.Eventually(t, func() bool {
+		getCar, err := remote.GetCache(context.Background(), car.ID)
+		return err == nil && getCar.Brand == updated.Brand
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestCachedCarIDs(t *testing.T) {
+	car := model.Car{ID: uuid.New(), Brand: RandBrand(), ProductionYear: RandProductionYear()}
+	err := rdsRps.SetCache(context.Background(), &car)
+	require.NoError(t, err)
+	found := false
+	var cursor uint64
+	for {
+		ids, next, err := rdsRps.CachedCarIDs(context.Background(), cursor, 100)
+		require.NoError(t, err)
+		for _, id := range ids {
+			found = found || id == car.ID
+		}
+		cursor = next
+		if cursor == 0 {
+			break
+		}
+	}
+	require.True(t, found)
+}
+
+// unreachableRedis is a Redis repository whose every call fails to connect.
+func unreachableRedis() *RedisRepository {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:1", MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+	return NewRedisRepository(client, &testConfig)
+}
+
+func TestDegradedCarCache(t *testing.T) {
+	cache := NewDegradedCarCache(unreachableRedis())
+	car := model.Car{ID: uuid.New(), Brand: RandBrand(), ProductionYear: RandProductionYear()}
+
+	_, err := cache.GetCache(context.Background(), car.ID)
+	require.Equal(t, redis.Nil, err)
+	cached, err := cache.GetCacheMany(context.Background(), []uuid.UUID{car.ID})
+	require.NoError(t, err)
+	require.Empty(t, cached)
+	require.NoError(t, cache.SetCache(context.Background(), &car))
+	require.NoError(t, cache.DeleteCache(context.Background(), car.ID))
+	_, err = cache.AcquireCarLoadLease(context.Background(), car.ID)
+	require.Error(t, err)
+	require.Equal(t, int64(5), cache.Failures())
+}
+
+func TestDegradedIdempotencyStore(t *testing.T) {
+	store := NewDegradedIdempotencyStore(unreachableRedis())
+	key := uuid.NewString()
+
+	stored, err := store.ReserveIdempotencyKey(context.Background(), key, "hash", time.Minute)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+	err = store.SaveIdempotentResponse(context.Background(), key, &model.IdempotentResponse{RequestHash: "hash"}, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, store.ReleaseIdempotencyKey(context.Background(), key))
+	require.Equal(t, int64(3), store.Failures())
+}
+
+// flakyEventBus is a bus of changes whose first subscription fails.
+type flakyEventBus struct {
+	*MemoryRepository
+	subscriptions int
+}
+
+func (f *flakyEventBus) Subscribe(ctx context.Context, send func(change *model.CarChange) error) error {
+	f.subscriptions++
+	if f.subscriptions == 1 {
+		return errors.New("subscription is closed")
+	}
+	return f.MemoryRepository.Subscribe(ctx, send)
+}
+
+func TestDegradedEventBus(t *testing.T) {
+	change := &model.CarChange{ID: uuid.New(), CarID: testModel.ID, Action: model.CarCreated, After: &testModel}
+	unreachable := NewDegradedEventBus(unreachableRedis())
+	require.NoError(t, unreachable.Publish(context.Background(), change))
+	require.Equal(t, int64(1), unreachable.Failures())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := unreachable.Subscribe(ctx, func(*model.CarChange) error { return nil })
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, int64(2), unreachable.Failures())
+
+	bus := &flakyEventBus{MemoryRepository: NewMemoryRepository(&testConfig)}
+	errStop := errors.New("received")
+	errSubscribe := make(chan error, 1)
+	go func() {
+		errSubscribe <- NewDegradedEventBus(bus).Subscribe(context.Background(), func(*model.CarChange) error {
+			return errStop
+		})
+	}()
+	// the change is published until the subscription is set up again and receives it
+	require.Eventually(t, func() bool {
+		require.NoError(t, bus.Publish(context.Background(), change))
+		select {
+		case err = <-errSubscribe:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, err, errStop)
+}
@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+)
+
+// invalidationRetryDelay is how long ListenInvalidations waits before subscribing again after the subscription has failed.
+const invalidationRetryDelay = time.Second
+
+// TieredCarCache keeps the recently used cars in memory in front of the Redis cache, a hit doesn't leave the process.
+// The cars dropped by one instance are dropped by the others through Redis pub/sub, the short TTL of the local cache
+// bounds how long a car stays stale if an invalidation is lost.
+type TieredCarCache struct {
+	*RedisRepository
+	local *carLRU
+	// source tells the invalidations of this instance from the ones of the others.
+	source string
+}
+
+// NewTieredCarCache creates a local cache of cfg.CarLocalCacheSize cars which expire after cfg.CarLocalCacheTTL in front of redisRepo.
+// ListenInvalidations has to run for the invalidations of the other instances to be applied.
+func NewTieredCarCache(redisRepo *RedisRepository, cfg *config.Config) *TieredCarCache {
+	return &TieredCarCache{
+		RedisRepository: redisRepo,
+		local:           newCarLRU(cfg.CarLocalCacheSize, cfg.CarLocalCacheTTL),
+		source:          uuid.NewString(),
+	}
+}
+
+// GetCache retrieves the car from the local cache and then from Redis, the car found in Redis is kept locally.
+func (t *TieredCarCache) GetCache(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	if car, ok := t.local.get(id); ok {
+		return car, nil
+	}
+	car, err := t.RedisRepository.GetCache(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	t.local.put(car)
+	return car, nil
+}
+
+// GetCacheMany retrieves the cars from the local cache and the rest of them from Redis in one round trip.
+func (t *TieredCarCache) GetCacheMany(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error) {
+	cars := make(map[uuid.UUID]*model.Car, len(ids))
+	var missed []uuid.UUID
+	for _, id := range ids {
+		if car, ok := t.local.get(id); ok {
+			cars[id] = car
+		} else {
+			missed = append(missed, id)
+		}
+	}
+	if len(missed) == 0 {
+		return cars, nil
+	}
+	cached, err := t.RedisRepository.GetCacheMany(ctx, missed)
+	if err != nil {
+		return nil, err
+	}
+	for id, car := range cached {
+		t.local.put(car)
+		cars[id] = car
+	}
+	return cars, nil
+}
+
+// SetCache stores the car in Redis and in the local cache.
+func (t *TieredCarCache) SetCache(ctx context.Context, car *model.Car) error {
+	return t.SetCacheMany(ctx, []*model.Car{car})
+}
+
+// SetCacheMany stores the cars in Redis and drops them from the local caches of every instance, the next local read
+// takes them from Redis. Redis skips a car older than the cached one, so the cars aren't put into the local cache here.
+func (t *TieredCarCache) SetCacheMany(ctx context.Context, cars []*model.Car) error {
+	if len(cars) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(cars))
+	for _, car := range cars {
+		ids = append(ids, car.ID)
+	}
+	// the cars are dropped after Redis is written, a read of this instance meanwhile may have put the old cars back
+	defer t.local.remove(ids...)
+	err := t.RedisRepository.SetCacheMany(ctx, cars)
+	if err != nil {
+		return err
+	}
+	err = t.PublishCacheInvalidation(ctx, t.source, ids)
+	if err != nil {
+		return fmt.Errorf("TieredCarCache-SetCacheMany: %w", err)
+	}
+	return nil
+}
+
+// SetCacheNotFound drops the car from the local cache and remembers in Redis that there is no such car.
+func (t *TieredCarCache) SetCacheNotFound(ctx context.Context, id uuid.UUID) error {
+	t.local.remove(id)
+	return t.RedisRepository.SetCacheNotFound(ctx, id)
+}
+
+// DeleteCache drops the car from the local cache, from Redis and from the local caches of the other instances.
+func (t *TieredCarCache) DeleteCache(ctx context.Context, id uuid.UUID) error {
+	return t.DeleteCacheMany(ctx, []uuid.UUID{id})
+}
+
+// DeleteCacheMany drops the cars from the local cache, from Redis and from the local caches of the other instances.
+func (t *TieredCarCache) DeleteCacheMany(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	t.local.remove(ids...)
+	err := t.RedisRepository.DeleteCacheMany(ctx, ids)
+	if err != nil {
+		return err
+	}
+	err = t.PublishCacheInvalidation(ctx, t.source, ids)
+	if err != nil {
+		return fmt.Errorf("TieredCarCache-DeleteCacheMany: %w", err)
+	}
+	return nil
+}
+
+// ListenInvalidations drops the cars invalidated by the other instances from the local cache until ctx is done.
+// The local cache is cleared every time the subscription is set up, since the invalidations published in between are lost.
+func (t *TieredCarCache) ListenInvalidations(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := t.SubscribeCacheInvalidations(ctx, t.local.clear, func(source string, ids []uuid.UUID) {
+			if source != t.source {
+				t.local.remove(ids...)
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		fmt.Printf("TieredCarCache-ListenInvalidations: subscription failed: %v", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(invalidationRetryDelay):
+		}
+	}
+}
+
+// carLRU is a local cache of a bounded number of cars, the least recently used car is evicted when it is full.
+type carLRU struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List // the most recently used car is at the front
+	entries map[uuid.UUID]*list.Element
+}
+
+// carLRUEntry is a car of the local cache with the time it expires at.
+type carLRUEntry struct {
+	car       model.Car
+	expiresAt time.Time
+}
+
+// newCarLRU creates a local cache of size cars which expire after ttl.
+func newCarLRU(size int, ttl time.Duration) *carLRU {
+	return &carLRU{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[uuid.UUID]*list.Element),
+	}
+}
+
+// get returns a copy of the car if it is cached and not expired.
+func (c *carLRU) get(id uuid.UUID) (*model.Car, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*carLRUEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, id)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	car := entry.car
+	return &car, true
+}
+
+// put stores a copy of the car unless a newer version of it is cached and reports whether it was stored,
+// the least recently used car is evicted if the cache is full.
+func (c *carLRU) put(car *model.Car) bool {
+	if c.size <= 0 {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := &carLRUEntry{car: *car, expiresAt: time.Now().Add(c.ttl)}
+	if elem, ok := c.entries[car.ID]; ok {
+		cached := elem.Value.(*carLRUEntry)
+		if cached.car.Version > car.Version && time.Now().Before(cached.expiresAt) {
+			return false
+		}
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return true
+	}
+	c.entries[car.ID] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*carLRUEntry).car.ID)
+	}
+	return true
+}
+
+// remove drops the cars from the cache.
+func (c *carLRU) remove(ids ...uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range ids {
+		if elem, ok := c.entries[id]; ok {
+			c.order.Remove(elem)
+			delete(c.entries, id)
+		}
+	}
+}
+
+// ids returns the IDs of the cached cars, the expired ones included.
+func (c *carLRU) ids() []uuid.UUID {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ids := make([]uuid.UUID, 0, len(c.entries))
+	for id := range c.entries {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// clear drops all cars from the cache.
+func (c *carLRU) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.entries = make(map[uuid.UUID]*list.Element)
+}
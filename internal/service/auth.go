@@ -1,10 +1,12 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -78,3 +80,17 @@ func CheckTokenValidity(token, signature string) (uuid.UUID, bool, error) {
 	}
 	return tokenID, admin, nil
 }
+
+// identityKey is the context key under which the caller identity is stored.
+type identityKey struct{}
+
+// ContextWithIdentity returns a copy of ctx which carries the identity of the caller.
+func ContextWithIdentity(ctx context.Context, identity model.Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the identity of the caller stored in ctx.
+func IdentityFromContext(ctx context.Context) (model.Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(model.Identity)
+	return identity, ok
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+)
+
+// BrandRepository is an interface that defines the methods on the brand catalog.
+type BrandRepository interface {
+	CreateBrand(ctx context.Context, brand *model.Brand) error
+	GetBrand(ctx context.Context, id uuid.UUID) (*model.Brand, error)
+	ListBrands(ctx context.Context) ([]*model.Brand, error)
+	UpdateBrand(ctx context.Context, brand *model.Brand) ([]uuid.UUID, error)
+	DeleteBrand(ctx context.Context, id uuid.UUID) error
+	ResolveBrand(ctx context.Context, name string) (*model.Brand, error)
+	CarBrands(ctx context.Context) ([]string, error)
+	SetCarsBrand(ctx context.Context, from string, brand *model.Brand) ([]uuid.UUID, error)
+}
+
+// BrandResolver is an interface that defines the method resolving the brand of a car to a brand of the catalog.
+type BrandResolver interface {
+	ResolveBrand(ctx context.Context, name string) (*model.Brand, error)
+}
+
+// CarCacheInvalidator is an interface that defines the method dropping a car from the cache.
+type CarCacheInvalidator interface {
+	DeleteCache(ctx context.Context, id uuid.UUID) error
+}
+
+// BrandEntity represents the service that manages the brand catalog. Everyone may read the catalog, only admins may change it.
+type BrandEntity struct {
+	repo  BrandRepository
+	cache CarCacheInvalidator
+}
+
+// NewBrandEntity creates a new instance of the service, the cars whose brand changes are dropped from the cache.
+func NewBrandEntity(repo BrandRepository, cache CarCacheInvalidator) *BrandEntity {
+	return &BrandEntity{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Create adds a new brand to the catalog, model.ErrDuplicateBrand is returned if its name or an alias is used by another brand.
+func (s *BrandEntity) Create(ctx context.Context, brand *model.Brand) error {
+	err := checkAdmin(ctx)
+	if err != nil {
+		return fmt.Errorf("BrandEntity-Create: %w", err)
+	}
+	brand.ID = uuid.New()
+	err = s.repo.CreateBrand(ctx, brand)
+	if err != nil {
+		return fmt.Errorf("BrandEntity-Create: error in method s.repo.CreateBrand: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a brand of the catalog by its ID.
+func (s *BrandEntity) Get(ctx context.Context, id uuid.UUID) (*model.Brand, error) {
+	brand, err := s.repo.GetBrand(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("BrandEntity-Get: error in method s.repo.GetBrand: %w", err)
+	}
+	return brand, nil
+}
+
+// List retrieves all brands of the catalog ordered by name.
+func (s *BrandEntity) List(ctx context.Context) ([]*model.Brand, error) {
+	brands, err := s.repo.ListBrands(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("BrandEntity-List: error in method s.repo.ListBrands: %w", err)
+	}
+	return brands, nil
+}
+
+// Update replaces the name and the aliases of a brand, the cars of a renamed brand get the new name.
+func (s *BrandEntity) Update(ctx context.Context, brand *model.Brand) error {
+	err := checkAdmin(ctx)
+	if err != nil {
+		return fmt.Errorf("BrandEntity-Update: %w", err)
+	}
+	renamed, err := s.repo.UpdateBrand(ctx, brand)
+	if err != nil {
+		return fmt.Errorf("BrandEntity-Update: error in method s.repo.UpdateBrand: %w", err)
+	}
+	s.invalidate(ctx, renamed)
+	return nil
+}
+
+// Delete removes a brand from the catalog, model.ErrBrandInUse is returned if the brand still has cars.
+func (s *BrandEntity) Delete(ctx context.Context, id uuid.UUID) error {
+	err := checkAdmin(ctx)
+	if err != nil {
+		return fmt.Errorf("BrandEntity-Delete: %w", err)
+	}
+	err = s.repo.DeleteBrand(ctx, id)
+	if err != nil {
+		return fmt.Errorf("BrandEntity-Delete: error in method s.repo.DeleteBrand: %w", err)
+	}
+	return nil
+}
+
+// NormalizeCars moves the existing cars to the brands of the catalog their brands resolve to.
+// The brands which resolve to nothing are added to the catalog if createMissing is set, otherwise their cars are left as they are.
+// It is meant to be run once after the catalog is filled, so it doesn't check the caller.
+func (s *BrandEntity) NormalizeCars(ctx context.Context, createMissing bool) (*model.BrandNormalization, error) {
+	carBrands, err := s.repo.CarBrands(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("BrandEntity-NormalizeCars: error in method s.repo.CarBrands: %w", err)
+	}
+	result := &model.BrandNormalization{}
+	for _, carBrand := range carBrands {
+		brand, err := s.repo.ResolveBrand(ctx, carBrand)
+		if errors.Is(err, model.ErrUnknownBrand) && createMissing {
+			brand = &model.Brand{ID: uuid.New(), Name: carBrand}
+			err = s.repo.CreateBrand(ctx, brand)
+			if errors.Is(err, model.ErrDuplicateBrand) {
+				// another spelling of the same brand was created earlier in this run
+				brand, err = s.repo.ResolveBrand(ctx, carBrand)
+			} else if err == nil {
+				result.Created = append(result.Created, carBrand)
+			}
+		}
+		if errors.Is(err, model.ErrUnknownBrand) {
+			result.Unknown = append(result.Unknown, carBrand)
+			continue
+		}
+		if err != nil {
+			return result, fmt.Errorf("BrandEntity-NormalizeCars: brand %q: %w", carBrand, err)
+		}
+		ids, err := s.repo.SetCarsBrand(ctx, carBrand, brand)
+		if err != nil {
+			return result, fmt.Errorf("BrandEntity-NormalizeCars: error in method s.repo.SetCarsBrand: %w", err)
+		}
+		result.Updated += int64(len(ids))
+		s.invalidate(ctx, ids)
+	}
+	return result, nil
+}
+
+// invalidate drops the cars from the cache, the cache may be missing when the service runs outside of the server.
+func (s *BrandEntity) invalidate(ctx context.Context, ids []uuid.UUID) {
+	if s.cache == nil {
+		return
+	}
+	for _, id := range ids {
+		_ = s.cache.DeleteCache(ctx, id)
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+)
+
+// sniffLen is the number of the first bytes of an upload the content type is detected by.
+const sniffLen = 512
+
+// CarImageRepository is an interface that defines the methods on the metadata of the images of cars.
+type CarImageRepository interface {
+	AddImage(ctx context.Context, image *model.CarImage) error
+	GetImages(ctx context.Context, carID uuid.UUID) ([]*model.CarImage, error)
+	SetPrimaryImage(ctx context.Context, carID, imageID uuid.UUID) error
+}
+
+// CarImageEntity represents the service that stores the images of cars and their metadata.
+type CarImageEntity struct {
+	images CarImageRepository
+	cars   *CarEntity
+	cfg    *config.Config
+}
+
+// NewCarImageEntity creates a new instance of the service, the cars are used to check access to their images.
+func NewCarImageEntity(images CarImageRepository, cars *CarEntity, cfg *config.Config) *CarImageEntity {
+	return &CarImageEntity{
+		images: images,
+		cars:   cars,
+		cfg:    cfg,
+	}
+}
+
+// Upload stores the image read from data and attaches it to the car after the other images of the car.
+// The content type is detected from the data, non-admins may attach images only to their own cars.
+func (s *CarImageEntity) Upload(ctx context.Context, carID uuid.UUID, data io.Reader) (*model.CarImage, error) {
+	_, err := s.cars.Get(ctx, carID)
+	if err != nil {
+		return nil, fmt.Errorf("CarImageEntity-Upload: error in method s.cars.Get: %w", err)
+	}
+	reader := bufio.NewReaderSize(data, sniffLen)
+	head, err := reader.Peek(sniffLen)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("CarImageEntity-Upload: error in method reader.Peek(): %w", err)
+	}
+	image := &model.CarImage{
+		ID:          uuid.New(),
+		CarID:       carID,
+		ContentType: http.DetectContentType(head),
+		CreatedAt:   time.Now().UTC().Truncate(time.Millisecond),
+	}
+	if !model.IsCarImageType(image.ContentType) {
+		return nil, fmt.Errorf("CarImageEntity-Upload: %w: %s", model.ErrUnsupportedImageType, image.ContentType)
+	}
+	path := filepath.Join("images", model.CarImageDir, image.FileName())
+	err = s.saveFile(path, reader, image)
+	if err != nil {
+		return nil, fmt.Errorf("CarImageEntity-Upload: %w", err)
+	}
+	err = s.images.AddImage(ctx, image)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("CarImageEntity-Upload: error in method s.images.AddImage: %w", err)
+	}
+	return image, nil
+}
+
+// saveFile writes data to the file at path and sets the size and the checksum of the image,
+// the file is removed if it can't be written completely or is larger than allowed.
+func (s *CarImageEntity) saveFile(path string, data io.Reader, image *model.CarImage) (err error) {
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return fmt.Errorf("error in method os.MkdirAll(): %w", err)
+	}
+	file, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("error in method os.Create(): %w", err)
+	}
+	defer func() {
+		errClose := file.Close()
+		if err == nil && errClose != nil {
+			err = fmt.Errorf("error in method file.Close(): %w", errClose)
+		}
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), io.LimitReader(data, s.cfg.MaxCarImageSize+1))
+	if err != nil {
+		return fmt.Errorf("error in method io.Copy(): %w", err)
+	}
+	if size > s.cfg.MaxCarImageSize {
+		return fmt.Errorf("%w: limit is %d bytes", model.ErrCarImageTooLarge, s.cfg.MaxCarImageSize)
+	}
+	image.Size = size
+	image.Checksum = hex.EncodeToString(hash.Sum(nil))
+	return nil
+}
+
+// List retrieves the metadata of the images of a car in their order, non-admins may list only the images of their own cars.
+func (s *CarImageEntity) List(ctx context.Context, carID uuid.UUID) ([]*model.CarImage, error) {
+	_, err := s.cars.Get(ctx, carID)
+	if err != nil {
+		return nil, fmt.Errorf("CarImageEntity-List: error in method s.cars.Get: %w", err)
+	}
+	images, err := s.images.GetImages(ctx, carID)
+	if err != nil {
+		return nil, fmt.Errorf("CarImageEntity-List: error in method s.images.GetImages: %w", err)
+	}
+	return images, nil
+}
+
+// SetPrimary makes the image the first one of the car and returns the images of the car in their new order.
+func (s *CarImageEntity) SetPrimary(ctx context.Context, carID, imageID uuid.UUID) ([]*model.CarImage, error) {
+	_, err := s.cars.Get(ctx, carID)
+	if err != nil {
+		return nil, fmt.Errorf("CarImageEntity-SetPrimary: error in method s.cars.Get: %w", err)
+	}
+	err = s.images.SetPrimaryImage(ctx, carID, imageID)
+	if err != nil {
+		return nil, fmt.Errorf("CarImageEntity-SetPrimary: error in method s.images.SetPrimaryImage: %w", err)
+	}
+	images, err := s.images.GetImages(ctx, carID)
+	if err != nil {
+		return nil, fmt.Errorf("CarImageEntity-SetPrimary: error in method s.images.GetImages: %w", err)
+	}
+	return images, nil
+}
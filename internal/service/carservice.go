@@ -3,94 +3,962 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
+	"time"
 
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
 	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/internal/validation"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+	"gopkg.in/go-playground/validator.v9"
 )
 
 // CarRepository is an interface that defines the methods on entities.
 type CarRepository interface {
 	Create(ctx context.Context, car *model.Car) error
 	Get(ctx context.Context, id uuid.UUID) (*model.Car, error)
-	Delete(ctx context.Context, id uuid.UUID) error
-	Update(ctx context.Context, car *model.Car) error
-	GetAll(ctx context.Context) ([]*model.Car, error)
+	GetMany(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error)
+	GetByVIN(ctx context.Context, vin string) (*model.Car, error)
+	Delete(ctx context.Context, id uuid.UUID) (*model.Car, error)
+	Update(ctx context.Context, car *model.Car, fields []string) error
+	GetAll(ctx context.Context, filter *model.CarFilter) ([]*model.Car, string, error)
+	Stream(ctx context.Context, filter *model.CarFilter, send func(car *model.Car) error) error
+	Restore(ctx context.Context, id uuid.UUID) (*model.Car, error)
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	BatchCreate(ctx context.Context, cars []*model.Car) error
+	BatchUpdate(ctx context.Context, updates []*model.CarUpdate) error
+	BatchDelete(ctx context.Context, ids []uuid.UUID) ([]*model.Car, error)
+	ExistingIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]bool, error)
+	Stats(ctx context.Context, ownerID uuid.UUID) (*model.CarStats, error)
 }
 
+const (
+	// DefaultPageSize is the number of cars in a page when the client doesn't ask for a size.
+	DefaultPageSize = 50
+	// MaxPageSize is the largest number of cars returned in one page.
+	MaxPageSize = 1000
+	// MaxBatchSize is the largest number of cars changed by one batch.
+	MaxBatchSize = 500
+	// cacheReconcileBatchSize is how many cached cars ReconcileCache compares with the database at once.
+	cacheReconcileBatchSize = 500
+	// carLoadPollInterval is how often a miss waiting for the loader of another instance looks into the cache.
+	carLoadPollInterval = 20 * time.Millisecond
+	// carLoadQueryTimeout bounds the database read of a shared load on top of the wait for the loader of another instance.
+	carLoadQueryTimeout = 5 * time.Second
+)
+
 // RedisCarRepository is an interface that defines the redis methods on entities.
 type RedisCarRepository interface {
 	GetCache(ctx context.Context, id uuid.UUID) (*model.Car, error)
+	GetCacheMany(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*model.Car, error)
+	GetCacheByVIN(ctx context.Context, vin string) (*model.Car, error)
 	SetCache(ctx context.Context, car *model.Car) error
+	SetCacheMany(ctx context.Context, cars []*model.Car) error
+	SetCacheNotFound(ctx context.Context, id uuid.UUID) error
+	AcquireCarLoadLease(ctx context.Context, id uuid.UUID) (string, error)
+	ReleaseCarLoadLease(ctx context.Context, id uuid.UUID, token string) error
 	DeleteCache(ctx context.Context, id uuid.UUID) error
+	DeleteCacheMany(ctx context.Context, ids []uuid.UUID) error
+	CachedCarIDs(ctx context.Context, cursor uint64, count int64) ([]uuid.UUID, uint64, error)
+	GetStatsCache(ctx context.Context, ownerID uuid.UUID) (*model.CarStats, error)
+	SetStatsCache(ctx context.Context, ownerID uuid.UUID, stats *model.CarStats, ttl time.Duration) error
+}
+
+// CarHistoryRepository is an interface that defines the methods on the history of cars.
+type CarHistoryRepository interface {
+	AddChange(ctx context.Context, change *model.CarChange) error
+	GetHistory(ctx context.Context, filter *model.CarHistoryFilter) ([]*model.CarChange, string, error)
+}
+
+// CarEventBus is an interface that defines the methods delivering the changes of cars to their watchers.
+type CarEventBus interface {
+	Publish(ctx context.Context, change *model.CarChange) error
+	Subscribe(ctx context.Context, send func(change *model.CarChange) error) error
 }
 
 // CarEntity represents the service that interacts with the repository.
 type CarEntity struct {
-	rpc    CarRepository
-	rdsRep RedisCarRepository
+	rpc     CarRepository
+	rdsRep  RedisCarRepository
+	history CarHistoryRepository
+	events  CarEventBus
+	brands  BrandResolver
+	cfg     *config.Config
+	// validate checks the cars merged from updates, the handler can check only the changed fields.
+	validate *validator.Validate
+	// loads coalesces the concurrent misses of the same car, so only one of them reads the database.
+	loads singleflight.Group
 }
 
-// NewCarEntity creates a new instance of the service.
-func NewCarEntity(rpc CarRepository, rdsRep RedisCarRepository) *CarEntity {
+// NewCarEntity creates a new instance of the service, the brands of the created and updated cars are resolved with brands.
+func NewCarEntity(rpc CarRepository, rdsRep RedisCarRepository, history CarHistoryRepository, events CarEventBus, brands BrandResolver,
+	cfg *config.Config) *CarEntity {
 	return &CarEntity{
-		rpc:    rpc,
-		rdsRep: rdsRep,
+		rpc:      rpc,
+		rdsRep:   rdsRep,
+		history:  history,
+		events:   events,
+		brands:   brands,
+		cfg:      cfg,
+		validate: validation.New(cfg),
 	}
 }
 
-// Create creates a new car.
+// Create creates a new car owned by the caller, its brand is replaced with the brand of the catalog it resolves to.
 func (s *CarEntity) Create(ctx context.Context, car *model.Car) error {
-	err := s.rpc.Create(ctx, car)
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("CarEntity-Create: %w", model.ErrUnauthenticated)
+	}
+	err := s.resolveBrand(ctx, car)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Create: %w", err)
+	}
+	err = s.validateCar(ctx, car)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Create: %w", err)
+	}
+	car.OwnerID = identity.UserID
+	car.Version = 1
+	err = s.rpc.Create(ctx, car)
 	if err != nil {
 		return fmt.Errorf("CarEntity-Create: error in method s.rpc.Create: %w", err)
 	}
-	err = s.rdsRep.SetCache(ctx, car)
+	s.refreshCache(ctx, car)
+	after := *car
+	err = s.recordChange(ctx, model.CarCreated, nil, &after)
 	if err != nil {
-		return fmt.Errorf("CarEntity-Create: error in method s.rdsRep.SetCache: %w", err)
+		return fmt.Errorf("CarEntity-Create: %w", err)
 	}
 	return nil
 }
 
-// Update updates an existing car.
-func (s *CarEntity) Update(ctx context.Context, car *model.Car) error {
-	_ = s.rdsRep.DeleteCache(ctx, car.ID)
-	_ = s.rdsRep.SetCache(ctx, car)
-	err := s.rpc.Update(ctx, car)
+// Update changes the given fields of an existing car if car.Version is the version currently stored,
+// car is filled with the whole updated car. The owner of the car stays the same.
+func (s *CarEntity) Update(ctx context.Context, car *model.Car, fields []string) error {
+	current, err := s.Get(ctx, car.ID)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Update: error in method s.Get: %w", err)
+	}
+	current, err = s.atVersion(ctx, current, car.Version)
 	if err != nil {
+		return fmt.Errorf("CarEntity-Update: %w", err)
+	}
+	updated := *current
+	err = copyCarFields(&updated, car, fields)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Update: %w", err)
+	}
+	if hasField(fields, model.CarFieldBrand) {
+		err = s.resolveBrand(ctx, &updated)
+		if err != nil {
+			return fmt.Errorf("CarEntity-Update: %w", err)
+		}
+	}
+	err = s.validateCar(ctx, &updated)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Update: %w", err)
+	}
+	updated.Version = car.Version
+	err = s.rpc.Update(ctx, &updated, fields)
+	if err != nil {
+		// a version conflict means the cached car is behind the database
+		_ = s.rdsRep.DeleteCache(ctx, car.ID)
 		return fmt.Errorf("CarEntity-Update: error in method s.rpc.Update: %w", err)
 	}
+	*car = updated
+	s.refreshCache(ctx, car)
+	before, after := *current, updated
+	err = s.recordChange(ctx, model.CarUpdated, &before, &after)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Update: %w", err)
+	}
 	return nil
 }
 
-// Get retrieves a car by its ID.
+// atVersion returns the car read from the database if the cached car isn't at the version the update is made for,
+// so the update is merged into the stored car and recorded as its change rather than the change of a stale cached car.
+func (s *CarEntity) atVersion(ctx context.Context, cached *model.Car, version int64) (*model.Car, error) {
+	if cached.Version == version {
+		return cached, nil
+	}
+	car, err := s.rpc.Get(ctx, cached.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error in method s.rpc.Get: %w", err)
+	}
+	err = checkOwner(ctx, car)
+	if err != nil {
+		return nil, err
+	}
+	return car, nil
+}
+
+// Get retrieves a car by its ID, non-admins may get only their own cars.
+// A missed car is loaded by one caller per process and by one process at a time, the IDs of missing cars are cached too.
 func (s *CarEntity) Get(ctx context.Context, id uuid.UUID) (*model.Car, error) {
 	car, err := s.rdsRep.GetCache(ctx, id)
+	if errors.Is(err, model.ErrCarNotFound) {
+		return nil, fmt.Errorf("CarEntity-Get: %w", err)
+	}
 	if err != nil && err != redis.Nil {
 		return nil, fmt.Errorf("CarEntity-Get: error in method s.rpc.GetCache: %w", err)
 	}
+	if car != nil && car.DeletedAt != nil {
+		return nil, fmt.Errorf("CarEntity-Get: %w", model.ErrCarNotFound)
+	}
 	if car == nil {
-		car, err = s.rpc.Get(ctx, id)
+		// the load is shared by the callers, so it doesn't end with the context of the first of them
+		loads := s.loads.DoChan(id.String(), func() (interface{}, error) {
+			loadCtx, cancel := context.WithTimeout(DetachedContext(ctx), s.cfg.CarLoadLeaseTTL+carLoadQueryTimeout)
+			defer cancel()
+			return s.loadCar(loadCtx, id)
+		})
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("CarEntity-Get: %w", ctx.Err())
+		case loaded := <-loads:
+			if loaded.Err != nil {
+				return nil, fmt.Errorf("CarEntity-Get: %w", loaded.Err)
+			}
+			// the callers sharing the load get their own copies
+			carCopy := *loaded.Val.(*model.Car)
+			car = &carCopy
+		}
+	}
+	err = checkOwner(ctx, car)
+	if err != nil {
+		return nil, fmt.Errorf("CarEntity-Get: %w", err)
+	}
+	return car, nil
+}
+
+// loadCar reads a missed car from the database and caches it. While another instance holds the lease to load the car,
+// it waits for the car to show up in the cache and reads the database itself only if the lease expires first.
+func (s *CarEntity) loadCar(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	token, err := s.rdsRep.AcquireCarLoadLease(ctx, id)
+	if err == nil && token == "" {
+		car, errWait := s.waitForLoad(ctx, id)
+		if car != nil || errWait != nil {
+			return car, errWait
+		}
+	}
+	if token != "" {
+		defer func() {
+			_ = s.rdsRep.ReleaseCarLoadLease(context.Background(), id, token)
+		}()
+		// the car may have been loaded or deleted between the miss and the lease
+		car, errCache := s.rdsRep.GetCache(ctx, id)
+		if errCache == nil {
+			return liveCar(car)
+		}
+	}
+	car, err := s.rpc.Get(ctx, id)
+	if errors.Is(err, model.ErrCarNotFound) {
+		_ = s.rdsRep.SetCacheNotFound(ctx, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error in method s.rpc.Get: %w", err)
+	}
+	_ = s.rdsRep.SetCache(ctx, car)
+	return car, nil
+}
+
+// liveCar returns the cached car or model.ErrCarNotFound if the car is cached as deleted.
+func liveCar(car *model.Car) (*model.Car, error) {
+	if car.DeletedAt != nil {
+		return nil, model.ErrCarNotFound
+	}
+	return car, nil
+}
+
+// waitForLoad looks into the cache until the car loaded by another instance shows up or the lease of the loader expires,
+// nil is returned when the car didn't show up.
+func (s *CarEntity) waitForLoad(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	ticker := time.NewTicker(carLoadPollInterval)
+	defer ticker.Stop()
+	deadline := time.After(s.cfg.CarLoadLeaseTTL)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, nil
+		case <-ticker.C:
+			car, err := s.rdsRep.GetCache(ctx, id)
+			if errors.Is(err, model.ErrCarNotFound) {
+				return nil, err
+			}
+			if err == nil {
+				return liveCar(car)
+			}
+		}
+	}
+}
+
+// GetByVIN retrieves a car by its VIN, the cache is read before the database. Non-admins may get only their own cars.
+func (s *CarEntity) GetByVIN(ctx context.Context, vin string) (*model.Car, error) {
+	car, err := s.rdsRep.GetCacheByVIN(ctx, vin)
+	if err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("CarEntity-GetByVIN: error in method s.rdsRep.GetCacheByVIN: %w", err)
+	}
+	// the VIN of a deleted car may belong to another car by now
+	if car != nil && car.DeletedAt != nil {
+		car = nil
+	}
+	if car == nil {
+		car, err = s.rpc.GetByVIN(ctx, vin)
 		if err != nil {
-			return nil, fmt.Errorf("CarEntity-Get: error in method s.rpc.Get: %w", err)
+			return nil, fmt.Errorf("CarEntity-GetByVIN: error in method s.rpc.GetByVIN: %w", err)
 		}
 		_ = s.rdsRep.SetCache(ctx, car)
 	}
+	err = checkOwner(ctx, car)
+	if err != nil {
+		return nil, fmt.Errorf("CarEntity-GetByVIN: %w", err)
+	}
 	return car, nil
 }
 
-// Delete deletes a car by its ID.
+// getMany retrieves the cars by their IDs in the same order, the cached cars are read in one round trip and
+// only the rest is read from the database. Non-admins may get only their own cars, the error of a car is a model.BatchItemError.
+func (s *CarEntity) getMany(ctx context.Context, ids []uuid.UUID) ([]*model.Car, error) {
+	cached, err := s.rdsRep.GetCacheMany(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("error in method s.rdsRep.GetCacheMany: %w", err)
+	}
+	cars := make([]*model.Car, len(ids))
+	var loaded []*model.Car
+	for i, id := range ids {
+		car := cached[id]
+		if car != nil && car.DeletedAt != nil {
+			return nil, &model.BatchItemError{Index: i, Err: model.ErrCarNotFound}
+		}
+		if car == nil {
+			car, err = s.rpc.Get(ctx, id)
+			if err != nil {
+				return nil, &model.BatchItemError{Index: i, Err: fmt.Errorf("error in method s.rpc.Get: %w", err)}
+			}
+			loaded = append(loaded, car)
+		}
+		err = checkOwner(ctx, car)
+		if err != nil {
+			return nil, &model.BatchItemError{Index: i, Err: err}
+		}
+		cars[i] = car
+	}
+	_ = s.rdsRep.SetCacheMany(ctx, loaded)
+	return cars, nil
+}
+
+// Delete marks a car as deleted, non-admins may delete only their own cars. The deleted car is cached with its new version,
+// so a read which loaded the car before the deletion can't cache it again.
 func (s *CarEntity) Delete(ctx context.Context, id uuid.UUID) error {
-	err := s.rpc.Delete(ctx, id)
+	_, err := s.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Delete: error in method s.Get: %w", err)
+	}
+	deleted, err := s.rpc.Delete(ctx, id)
 	if err != nil {
 		return fmt.Errorf("CarEntity-Delete: error in method s.rpc.Delete: %w", err)
 	}
-	_ = s.rdsRep.DeleteCache(ctx, id)
+	s.refreshCache(ctx, deleted)
+	err = s.recordChange(ctx, model.CarDeleted, carBeforeDelete(deleted), nil)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Delete: %w", err)
+	}
+	return nil
+}
+
+// carBeforeDelete returns the car as it was before the deletion which returned the deleted car,
+// the deletion only marked the car and incremented its version.
+func carBeforeDelete(deleted *model.Car) *model.Car {
+	before := *deleted
+	before.DeletedAt = nil
+	before.Version--
+	return &before
+}
+
+// Restore brings back a deleted car, only admins may restore cars.
+func (s *CarEntity) Restore(ctx context.Context, id uuid.UUID) (*model.Car, error) {
+	err := checkAdmin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("CarEntity-Restore: %w", err)
+	}
+	car, err := s.rpc.Restore(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("CarEntity-Restore: error in method s.rpc.Restore: %w", err)
+	}
+	s.refreshCache(ctx, car)
+	after := *car
+	err = s.recordChange(ctx, model.CarRestored, nil, &after)
+	if err != nil {
+		return nil, fmt.Errorf("CarEntity-Restore: %w", err)
+	}
+	return car, nil
+}
+
+// PurgeDeleted permanently removes the cars deleted longer ago than the retention period, only admins may purge cars.
+func (s *CarEntity) PurgeDeleted(ctx context.Context) (int64, error) {
+	err := checkAdmin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("CarEntity-PurgeDeleted: %w", err)
+	}
+	purged, err := s.rpc.Purge(ctx, time.Now().Add(-s.cfg.DeletedCarRetention))
+	if err != nil {
+		return 0, fmt.Errorf("CarEntity-PurgeDeleted: error in method s.rpc.Purge: %w", err)
+	}
+	return purged, nil
+}
+
+// ReconcileCache compares every cached car with the database and drops the cached cars which differ from it or are no longer there,
+// the next read loads them from the database. They aren't cached again here, a car read from the database could be changed
+// by a concurrent update before it got into the cache. The cars are read from the database in batches of cacheReconcileBatchSize.
+// It is a background job, so it doesn't check the caller.
+func (s *CarEntity) ReconcileCache(ctx context.Context) (*model.CacheReconciliation, error) {
+	result := &model.CacheReconciliation{}
+	var cursor uint64
+	for {
+		ids, next, err := s.rdsRep.CachedCarIDs(ctx, cursor, cacheReconcileBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("CarEntity-ReconcileCache: error in method s.rdsRep.CachedCarIDs: %w", err)
+		}
+		cached, err := s.rdsRep.GetCacheMany(ctx, ids)
+		if err != nil {
+			return result, fmt.Errorf("CarEntity-ReconcileCache: error in method s.rdsRep.GetCacheMany: %w", err)
+		}
+		cachedIDs := make([]uuid.UUID, 0, len(cached))
+		for id := range cached {
+			cachedIDs = append(cachedIDs, id)
+		}
+		stored, err := s.rpc.GetMany(ctx, cachedIDs)
+		if err != nil {
+			return result, fmt.Errorf("CarEntity-ReconcileCache: error in method s.rpc.GetMany: %w", err)
+		}
+		var stale []uuid.UUID
+		for id, car := range cached {
+			result.Checked++
+			// a car cached as deleted matches a car which is no longer there
+			if (stored[id] == nil && car.DeletedAt == nil) || (stored[id] != nil && !sameCar(car, stored[id])) {
+				stale = append(stale, id)
+			}
+		}
+		if len(stale) > 0 {
+			err = s.rdsRep.DeleteCacheMany(ctx, stale)
+			if err != nil {
+				return result, fmt.Errorf("CarEntity-ReconcileCache: error in method s.rdsRep.DeleteCacheMany: %w", err)
+			}
+			result.Repaired += int64(len(stale))
+		}
+		cursor = next
+		if cursor == 0 {
+			return result, nil
+		}
+	}
+}
+
+// ReconcileCacheEvery runs ReconcileCache every interval until ctx is done and logs the outcome, it does nothing if interval isn't positive.
+func (s *CarEntity) ReconcileCacheEvery(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := s.ReconcileCache(ctx)
+			if err != nil {
+				logrus.Errorf("failed to reconcile car cache: %v", err)
+			}
+			if result.Repaired > 0 {
+				logrus.WithFields(logrus.Fields{
+					"checked":  result.Checked,
+					"repaired": result.Repaired,
+				}).Warn("repaired cached cars differing from the database")
+			}
+		}
+	}
+}
+
+// sameCar tells whether the cached car is the car stored in the database, the times of deletion are compared as instants
+// and no labels equal empty labels, as they come back from the cache.
+func sameCar(cached, stored *model.Car) bool {
+	a, b := *cached, *stored
+	if (a.DeletedAt == nil) != (b.DeletedAt == nil) || a.DeletedAt != nil && !a.DeletedAt.Equal(*b.DeletedAt) {
+		return false
+	}
+	a.DeletedAt, b.DeletedAt = nil, nil
+	if len(a.Labels) == 0 {
+		a.Labels = nil
+	}
+	if len(b.Labels) == 0 {
+		b.Labels = nil
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// BatchCreate creates all the cars owned by the caller at once, none of them are created if one fails.
+func (s *CarEntity) BatchCreate(ctx context.Context, cars []*model.Car) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("CarEntity-BatchCreate: %w", model.ErrUnauthenticated)
+	}
+	if len(cars) > MaxBatchSize {
+		return fmt.Errorf("CarEntity-BatchCreate: %w", model.ErrBatchTooLarge)
+	}
+	for i, car := range cars {
+		err := s.resolveBrand(ctx, car)
+		if err != nil {
+			return fmt.Errorf("CarEntity-BatchCreate: %w", &model.BatchItemError{Index: i, Err: err})
+		}
+		err = s.validateCar(ctx, car)
+		if err != nil {
+			return fmt.Errorf("CarEntity-BatchCreate: %w", &model.BatchItemError{Index: i, Err: err})
+		}
+		car.OwnerID = identity.UserID
+		car.Version = 1
+	}
+	err := s.rpc.BatchCreate(ctx, cars)
+	if err != nil {
+		return fmt.Errorf("CarEntity-BatchCreate: error in method s.rpc.BatchCreate: %w", err)
+	}
+	s.refreshCache(ctx, cars...)
+	for _, car := range cars {
+		after := *car
+		err = s.recordChange(ctx, model.CarCreated, nil, &after)
+		if err != nil {
+			return fmt.Errorf("CarEntity-BatchCreate: %w", err)
+		}
+	}
 	return nil
 }
 
-// GetAll retrieves all cars.
-func (s *CarEntity) GetAll(ctx context.Context) ([]*model.Car, error) {
-	return s.rpc.GetAll(ctx)
+// BatchUpdate applies all the updates at once, none of them are applied if one fails.
+// The cars of the updates are filled with the whole updated cars.
+func (s *CarEntity) BatchUpdate(ctx context.Context, updates []*model.CarUpdate) error {
+	if len(updates) > MaxBatchSize {
+		return fmt.Errorf("CarEntity-BatchUpdate: %w", model.ErrBatchTooLarge)
+	}
+	ids := make([]uuid.UUID, 0, len(updates))
+	for _, update := range updates {
+		ids = append(ids, update.Car.ID)
+	}
+	currents, err := s.getMany(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("CarEntity-BatchUpdate: %w", err)
+	}
+	updated := make([]*model.CarUpdate, len(updates))
+	for i, update := range updates {
+		currents[i], err = s.atVersion(ctx, currents[i], update.Car.Version)
+		if err != nil {
+			return fmt.Errorf("CarEntity-BatchUpdate: %w", &model.BatchItemError{Index: i, Err: err})
+		}
+		car := *currents[i]
+		err = copyCarFields(&car, update.Car, update.Fields)
+		if err != nil {
+			return fmt.Errorf("CarEntity-BatchUpdate: %w", &model.BatchItemError{Index: i, Err: err})
+		}
+		if hasField(update.Fields, model.CarFieldBrand) {
+			err = s.resolveBrand(ctx, &car)
+			if err != nil {
+				return fmt.Errorf("CarEntity-BatchUpdate: %w", &model.BatchItemError{Index: i, Err: err})
+			}
+		}
+		err = s.validateCar(ctx, &car)
+		if err != nil {
+			return fmt.Errorf("CarEntity-BatchUpdate: %w", &model.BatchItemError{Index: i, Err: err})
+		}
+		car.Version = update.Car.Version
+		updated[i] = &model.CarUpdate{Car: &car, Fields: update.Fields}
+	}
+	err = s.rpc.BatchUpdate(ctx, updated)
+	if err != nil {
+		_ = s.rdsRep.DeleteCacheMany(ctx, ids)
+		return fmt.Errorf("CarEntity-BatchUpdate: error in method s.rpc.BatchUpdate: %w", err)
+	}
+	cars := make([]*model.Car, 0, len(updates))
+	for i, update := range updates {
+		*update.Car = *updated[i].Car
+		cars = append(cars, update.Car)
+	}
+	s.refreshCache(ctx, cars...)
+	for i := range updates {
+		before, after := *currents[i], *updated[i].Car
+		err = s.recordChange(ctx, model.CarUpdated, &before, &after)
+		if err != nil {
+			return fmt.Errorf("CarEntity-BatchUpdate: %w", err)
+		}
+	}
+	return nil
+}
+
+// BatchDelete marks all the cars as deleted at once, none of them are marked if one fails.
+func (s *CarEntity) BatchDelete(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) > MaxBatchSize {
+		return fmt.Errorf("CarEntity-BatchDelete: %w", model.ErrBatchTooLarge)
+	}
+	_, err := s.getMany(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("CarEntity-BatchDelete: %w", err)
+	}
+	deleted, err := s.rpc.BatchDelete(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("CarEntity-BatchDelete: error in method s.rpc.BatchDelete: %w", err)
+	}
+	s.refreshCache(ctx, deleted...)
+	for _, car := range deleted {
+		err = s.recordChange(ctx, model.CarDeleted, carBeforeDelete(car), nil)
+		if err != nil {
+			return fmt.Errorf("CarEntity-BatchDelete: %w", err)
+		}
+	}
+	return nil
+}
+
+// Import creates the cars of the import rows owned by the caller in one batch and sets the status of every row,
+// the cars which already exist are skipped. Nothing is written in a dry run.
+func (s *CarEntity) Import(ctx context.Context, rows []*model.CarImportRow, dryRun bool) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("CarEntity-Import: %w", model.ErrUnauthenticated)
+	}
+	if len(rows) > MaxBatchSize {
+		return fmt.Errorf("CarEntity-Import: %w", model.ErrBatchTooLarge)
+	}
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.Car.ID)
+	}
+	existing, err := s.rpc.ExistingIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Import: error in method s.rpc.ExistingIDs: %w", err)
+	}
+	cars := make([]*model.Car, 0, len(rows))
+	pending := make([]*model.CarImportRow, 0, len(rows))
+	for _, row := range rows {
+		if existing[row.Car.ID] {
+			row.Status = model.CarImportSkipped
+			row.Reason = "car already exists"
+			continue
+		}
+		err = s.resolveBrand(ctx, row.Car)
+		if errors.Is(err, model.ErrUnknownBrand) {
+			row.Status = model.CarImportFailed
+			row.Reason = err.Error()
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("CarEntity-Import: %w", err)
+		}
+		err = s.validateCar(ctx, row.Car)
+		if err != nil {
+			row.Status = model.CarImportFailed
+			row.Reason = err.Error()
+			continue
+		}
+		row.Car.OwnerID = identity.UserID
+		row.Car.Version = 1
+		cars = append(cars, row.Car)
+		pending = append(pending, row)
+	}
+	if !dryRun && len(cars) > 0 {
+		// a car the database refuses, e.g. for a duplicate VIN, fails alone and the batch is retried without it
+		for len(cars) > 0 {
+			err = s.rpc.BatchCreate(ctx, cars)
+			var itemErr *model.BatchItemError
+			if err == nil || !errors.As(err, &itemErr) || ctx.Err() != nil {
+				break
+			}
+			pending[itemErr.Index].Status = model.CarImportFailed
+			pending[itemErr.Index].Reason = itemErr.Err.Error()
+			cars = append(cars[:itemErr.Index], cars[itemErr.Index+1:]...)
+			pending = append(pending[:itemErr.Index], pending[itemErr.Index+1:]...)
+			err = nil
+		}
+		if err != nil {
+			return fmt.Errorf("CarEntity-Import: error in method s.rpc.BatchCreate: %w", err)
+		}
+		s.refreshCache(ctx, cars...)
+		for _, car := range cars {
+			after := *car
+			err = s.recordChange(ctx, model.CarCreated, nil, &after)
+			if err != nil {
+				return fmt.Errorf("CarEntity-Import: %w", err)
+			}
+		}
+	}
+	for _, row := range rows {
+		if row.Status == model.CarImportPending {
+			row.Status = model.CarImported
+		}
+	}
+	return nil
+}
+
+// GetAll retrieves a page of cars matching the filter and the token of the next page, non-admins get only their own cars.
+func (s *CarEntity) GetAll(ctx context.Context, filter *model.CarFilter) ([]*model.Car, string, error) {
+	err := scopeToOwner(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("CarEntity-GetAll: %w", err)
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = DefaultPageSize
+	}
+	if filter.PageSize > MaxPageSize {
+		filter.PageSize = MaxPageSize
+	}
+	cars, nextPageToken, err := s.rpc.GetAll(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("CarEntity-GetAll: error in method s.rpc.GetAll: %w", err)
+	}
+	return cars, nextPageToken, nil
+}
+
+// Stats summarizes the cars by brand, production year and whether they are running, non-admins get only their own cars.
+// The statistics are cached for a short time, so they may lag behind the latest changes.
+func (s *CarEntity) Stats(ctx context.Context) (*model.CarStats, error) {
+	filter := &model.CarFilter{}
+	err := scopeToOwner(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("CarEntity-Stats: %w", err)
+	}
+	stats, err := s.rdsRep.GetStatsCache(ctx, filter.OwnerID)
+	if err != redis.Nil {
+		if err != nil {
+			return nil, fmt.Errorf("CarEntity-Stats: error in method s.rdsRep.GetStatsCache: %w", err)
+		}
+		return stats, nil
+	}
+	stats, err = s.rpc.Stats(ctx, filter.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("CarEntity-Stats: error in method s.rpc.Stats: %w", err)
+	}
+	_ = s.rdsRep.SetStatsCache(ctx, filter.OwnerID, stats, s.cfg.CarStatsCacheTTL)
+	return stats, nil
+}
+
+// Stream reads all cars matching the filter and passes them to send one by one without loading them all into memory.
+func (s *CarEntity) Stream(ctx context.Context, filter *model.CarFilter, send func(car *model.Car) error) error {
+	err := scopeToOwner(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Stream: %w", err)
+	}
+	filter.PageSize = 0
+	filter.PageToken = ""
+	err = s.rpc.Stream(ctx, filter, send)
+	if err != nil {
+		return fmt.Errorf("CarEntity-Stream: error in method s.rpc.Stream: %w", err)
+	}
+	return nil
+}
+
+// GetHistory retrieves a page of the history of a car and the token of the next page,
+// non-admins may get only the history of their own cars which are not deleted.
+func (s *CarEntity) GetHistory(ctx context.Context, filter *model.CarHistoryFilter) ([]*model.CarChange, string, error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return nil, "", fmt.Errorf("CarEntity-GetHistory: %w", model.ErrUnauthenticated)
+	}
+	if !identity.Admin {
+		_, err := s.Get(ctx, filter.CarID)
+		if err != nil {
+			return nil, "", fmt.Errorf("CarEntity-GetHistory: error in method s.Get: %w", err)
+		}
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = DefaultPageSize
+	}
+	if filter.PageSize > MaxPageSize {
+		filter.PageSize = MaxPageSize
+	}
+	changes, nextPageToken, err := s.history.GetHistory(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("CarEntity-GetHistory: error in method s.history.GetHistory: %w", err)
+	}
+	return changes, nextPageToken, nil
+}
+
+// Watch passes the changes of the cars matching the filter to send as they happen until ctx is done,
+// non-admins watch only their own cars.
+func (s *CarEntity) Watch(ctx context.Context, filter *model.CarWatchFilter, send func(change *model.CarChange) error) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("CarEntity-Watch: %w", model.ErrUnauthenticated)
+	}
+	err := s.events.Subscribe(ctx, func(change *model.CarChange) error {
+		if !filter.Matches(change) || (!identity.Admin && !ownsChangedCar(identity, change)) {
+			return nil
+		}
+		return send(change)
+	})
+	if err != nil {
+		return fmt.Errorf("CarEntity-Watch: error in method s.events.Subscribe: %w", err)
+	}
+	return nil
+}
+
+// ownsChangedCar reports whether the car belonged to the user before or after the change.
+func ownsChangedCar(identity model.Identity, change *model.CarChange) bool {
+	return (change.Before != nil && change.Before.OwnerID == identity.UserID) ||
+		(change.After != nil && change.After.OwnerID == identity.UserID)
+}
+
+// refreshCache caches the cars just written to the database, it must be called only after the write is committed,
+// so a failed write never leaves its cars in the cache. The cache keeps the newest version of a car, a write finishing
+// after a later one doesn't replace its car. If the cars can't be cached, their old versions are dropped,
+// so the next read loads them from the database. Failures of the cache are logged.
+func (s *CarEntity) refreshCache(ctx context.Context, cars ...*model.Car) {
+	err := s.rdsRep.SetCacheMany(ctx, cars)
+	if err == nil {
+		return
+	}
+	logrus.Errorf("failed to cache written cars: %v", err)
+	ids := make([]uuid.UUID, 0, len(cars))
+	for _, car := range cars {
+		ids = append(ids, car.ID)
+	}
+	err = s.rdsRep.DeleteCacheMany(ctx, ids)
+	if err != nil {
+		logrus.Errorf("failed to drop written cars from cache: %v", err)
+	}
+}
+
+// runningChanged caches and records the car whose running state was changed by the database together with its maintenance,
+// nothing but the running state and the version of the car were changed.
+func (s *CarEntity) runningChanged(ctx context.Context, car *model.Car) error {
+	s.refreshCache(ctx, car)
+	before, after := *car, *car
+	before.IsRunning = !car.IsRunning
+	before.Version--
+	return s.recordChange(ctx, model.CarUpdated, &before, &after)
+}
+
+// recordChange adds the change of a car made by the caller to the history of the car and publishes it to the watchers.
+func (s *CarEntity) recordChange(ctx context.Context, action model.CarChangeAction, before, after *model.Car) error {
+	identity, _ := IdentityFromContext(ctx)
+	change := &model.CarChange{
+		ID:      uuid.New(),
+		ActorID: identity.UserID,
+		Action:  action,
+		// The history is ordered by time, so it is kept at the precision both databases store.
+		ChangedAt: time.Now().UTC().Truncate(time.Millisecond),
+		Before:    before,
+		After:     after,
+	}
+	if after != nil {
+		change.CarID = after.ID
+	} else {
+		change.CarID = before.ID
+	}
+	err := s.history.AddChange(ctx, change)
+	if err != nil {
+		return fmt.Errorf("error in method s.history.AddChange: %w", err)
+	}
+	_ = s.events.Publish(ctx, change)
+	return nil
+}
+
+// resolveBrand replaces the brand of the car with the name of the brand of the catalog it resolves to and sets the brand ID,
+// model.ErrUnknownBrand is returned if the brand is not in the catalog. The car is validated after it, so the rules
+// which depend on the brand see its canonical name rather than an alias.
+func (s *CarEntity) resolveBrand(ctx context.Context, car *model.Car) error {
+	brand, err := s.brands.ResolveBrand(ctx, car.Brand)
+	if err != nil {
+		return fmt.Errorf("error in method s.brands.ResolveBrand: %w", err)
+	}
+	car.Brand = brand.Name
+	car.BrandID = brand.ID
+	return nil
+}
+
+// hasField reports whether field is one of fields.
+func hasField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOwner returns an error if the caller is neither an admin nor the owner of the car.
+func checkOwner(ctx context.Context, car *model.Car) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return model.ErrUnauthenticated
+	}
+	if !identity.Admin && identity.UserID != car.OwnerID {
+		return model.ErrPermissionDenied
+	}
+	return nil
+}
+
+// checkAdmin returns an error if the caller is not an admin.
+func checkAdmin(ctx context.Context) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return model.ErrUnauthenticated
+	}
+	if !identity.Admin {
+		return model.ErrPermissionDenied
+	}
+	return nil
+}
+
+// scopeToOwner limits the filter to the cars of the caller unless the caller is an admin.
+func scopeToOwner(ctx context.Context, filter *model.CarFilter) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return model.ErrUnauthenticated
+	}
+	if !identity.Admin {
+		filter.OwnerID = identity.UserID
+	}
+	return nil
+}
+
+// validateCar checks the whole car against the rules of the model tags. A car merged from an update is checked as a whole,
+// so the rules spanning several fields, like the first production year of the brand, see the fields which aren't changed too.
+func (s *CarEntity) validateCar(ctx context.Context, car *model.Car) error {
+	err := s.validate.StructCtx(ctx, car)
+	if err != nil {
+		return fmt.Errorf("%w: %w", model.ErrInvalidCar, err)
+	}
+	return nil
+}
+
+// copyCarFields copies the given fields from src to dst.
+func copyCarFields(dst, src *model.Car, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case model.CarFieldBrand:
+			dst.Brand = src.Brand
+		case model.CarFieldProductionYear:
+			dst.ProductionYear = src.ProductionYear
+		case model.CarFieldIsRunning:
+			dst.IsRunning = src.IsRunning
+		case model.CarFieldVIN:
+			dst.VIN = src.VIN
+		case model.CarFieldModel:
+			dst.Model = src.Model
+		case model.CarFieldMileage:
+			dst.Mileage = src.Mileage
+		case model.CarFieldColor:
+			dst.Color = src.Color
+		case model.CarFieldPrice:
+			dst.Price = src.Price
+		case model.CarFieldLabels:
+			dst.Labels = src.Labels
+		default:
+			return fmt.Errorf("%w: %s", model.ErrUnknownCarField, field)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/internal/repository"
+	"github.com/distuurbia/firstTaskArtyom/internal/service/mocks"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = config.Config{
+	CarCacheTTL:               time.Minute,
+	CarNotFoundCacheTTL:       10 * time.Second,
+	CarLoadLeaseTTL:           time.Second,
+	CarLocalCacheSize:         100,
+	ProductionYearMaxAge:      100,
+	ProductionYearMaxLead:     1,
+	BrandFirstProductionYears: config.BrandYears{"tesla": 2008},
+}
+
+// testBrandAliases are the aliases the mocked catalog resolves to the name of their brand, other names are brands themselves.
+var testBrandAliases = map[string]string{"TSLA": "Tesla"}
+
+// adminContext is the context of a call made by an admin.
+func adminContext() context.Context {
+	return ContextWithIdentity(context.Background(), model.Identity{UserID: uuid.New(), Admin: true})
+}
+
+// newTestCarEntity creates the service over the mocked database, the cars are cached in memory
+// and the brands are resolved by testBrandAliases.
+func newTestCarEntity(rpc *mocks.CarRepository) (*CarEntity, *repository.MemoryRepository) {
+	cache := repository.NewMemoryRepository(&testConfig)
+	return newTestCarEntityWithCache(rpc, cache), cache
+}
+
+// newTestCarEntityWithCache creates the service over the mocked database and the given cache.
+func newTestCarEntityWithCache(rpc *mocks.CarRepository, cache RedisCarRepository) *CarEntity {
+	history := new(mocks.CarHistoryRepository)
+	history.On("AddChange", mock.Anything, mock.Anything).Return(nil).Maybe()
+	events := new(mocks.CarEventBus)
+	events.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	brands := new(mocks.BrandResolver)
+	brands.On("ResolveBrand", mock.Anything, mock.AnythingOfType("string")).Return(
+		func(_ context.Context, name string) *model.Brand {
+			if brand, ok := testBrandAliases[name]; ok {
+				name = brand
+			}
+			return &model.Brand{ID: uuid.New(), Name: name}
+		}, nil).Maybe()
+	return NewCarEntity(rpc, cache, history, events, brands, &testConfig)
+}
+
+func TestUpdateValidatesMergedCar(t *testing.T) {
+	stored := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 1}
+	rpc := new(mocks.CarRepository)
+	rpc.On("Get", mock.Anything, stored.ID).Return(stored, nil).Once()
+	s, _ := newTestCarEntity(rpc)
+
+	update := &model.Car{ID: stored.ID, ProductionYear: 2005, Version: 1}
+	err := s.Update(adminContext(), update, []string{model.CarFieldProductionYear})
+	require.ErrorIs(t, err, model.ErrInvalidCar)
+	rpc.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	rpc.AssertExpectations(t)
+}
+
+func TestCreateValidatesResolvedBrand(t *testing.T) {
+	rpc := new(mocks.CarRepository)
+	s, _ := newTestCarEntity(rpc)
+
+	car := &model.Car{ID: uuid.New(), Brand: "TSLA", ProductionYear: 2005}
+	err := s.Create(adminContext(), car)
+	require.ErrorIs(t, err, model.ErrInvalidCar)
+	rpc.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+
+	rows := []*model.CarImportRow{{Car: &model.Car{ID: uuid.New(), Brand: "TSLA", ProductionYear: 2005}}}
+	rpc.On("ExistingIDs", mock.Anything, mock.Anything).Return(map[uuid.UUID]bool{}, nil).Once()
+	err = s.Import(adminContext(), rows, true)
+	require.NoError(t, err)
+	require.Equal(t, model.CarImportFailed, rows[0].Status)
+	rpc.AssertExpectations(t)
+}
+
+func TestGetLoadsMissOnce(t *testing.T) {
+	stored := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 1}
+	rpc := new(mocks.CarRepository)
+	rpc.On("Get", mock.Anything, stored.ID).Return(stored, nil).After(100 * time.Millisecond).Once()
+	s, _ := newTestCarEntity(rpc)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			car, err := s.Get(adminContext(), stored.ID)
+			if err == nil && car.ID != stored.ID {
+				err = model.ErrCarNotFound
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	rpc.AssertExpectations(t)
+}
+
+func TestGetSharedLoadOutlivesFirstCaller(t *testing.T) {
+	stored := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 1}
+	rpc := new(mocks.CarRepository)
+	// the database read fails when its context ends before it's done
+	rpc.On("Get", mock.Anything, stored.ID).Return(stored, func(ctx context.Context, _ uuid.UUID) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		}
+	}).Once()
+	s, _ := newTestCarEntity(rpc)
+
+	ctx, cancel := context.WithTimeout(adminContext(), 20*time.Millisecond)
+	defer cancel()
+	first := make(chan error, 1)
+	go func() {
+		_, err := s.Get(ctx, stored.ID)
+		first <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	car, err := s.Get(adminContext(), stored.ID)
+	require.NoError(t, err)
+	require.Equal(t, stored.ID, car.ID)
+	require.ErrorIs(t, <-first, context.DeadlineExceeded)
+	rpc.AssertExpectations(t)
+}
+
+// leasedCache is a cache where the lease to load every car is held by another instance which never loads it.
+type leasedCache struct {
+	*repository.MemoryRepository
+}
+
+func (leasedCache) AcquireCarLoadLease(context.Context, uuid.UUID) (string, error) {
+	return "", nil
+}
+
+func TestGetLoadsAfterLeaseExpired(t *testing.T) {
+	stored := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 1}
+	rpc := new(mocks.CarRepository)
+	rpc.On("Get", mock.Anything, stored.ID).Return(stored, nil).Once()
+	cache := leasedCache{repository.NewMemoryRepository(&testConfig)}
+	s := newTestCarEntityWithCache(rpc, cache)
+
+	started := time.Now()
+	car, err := s.Get(adminContext(), stored.ID)
+	require.NoError(t, err)
+	require.Equal(t, stored.ID, car.ID)
+	require.GreaterOrEqual(t, time.Since(started), testConfig.CarLoadLeaseTTL)
+	rpc.AssertExpectations(t)
+}
+
+func TestUpdateStaleCachedCar(t *testing.T) {
+	stale := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Color: "red", Version: 1}
+	stored := *stale
+	stored.Color = "blue"
+	stored.Version = 2
+	rpc := new(mocks.CarRepository)
+	rpc.On("Get", mock.Anything, stale.ID).Return(&stored, nil).Once()
+	// the database fills the car with the committed record
+	rpc.On("Update", mock.Anything, mock.MatchedBy(func(car *model.Car) bool {
+		return car.Color == stored.Color && car.Mileage == 1000 && car.Version == stored.Version
+	}), []string{model.CarFieldMileage}).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.Car).Version++
+	}).Return(nil).Once()
+	s, cache := newTestCarEntity(rpc)
+	require.NoError(t, cache.SetCache(context.Background(), stale))
+
+	update := &model.Car{ID: stale.ID, Mileage: 1000, Version: stored.Version}
+	err := s.Update(adminContext(), update, []string{model.CarFieldMileage})
+	require.NoError(t, err)
+	require.Equal(t, stored.Color, update.Color)
+	require.Equal(t, stored.Version+1, update.Version)
+	cached, err := cache.GetCache(context.Background(), stale.ID)
+	require.NoError(t, err)
+	require.Equal(t, stored.Color, cached.Color)
+	require.Equal(t, update.Version, cached.Version)
+	rpc.AssertExpectations(t)
+}
+
+func TestLoadKeepsNewerCachedCar(t *testing.T) {
+	loaded := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 1}
+	rpc := new(mocks.CarRepository)
+	rpc.On("Get", mock.Anything, loaded.ID).Return(loaded, nil).After(100 * time.Millisecond).Once()
+	s, cache := newTestCarEntity(rpc)
+
+	got := make(chan error, 1)
+	go func() {
+		_, err := s.Get(adminContext(), loaded.ID)
+		got <- err
+	}()
+	// an update is committed and cached while the old car is being read
+	time.Sleep(20 * time.Millisecond)
+	updated := *loaded
+	updated.Mileage = 1000
+	updated.Version++
+	s.refreshCache(context.Background(), &updated)
+	require.NoError(t, <-got)
+
+	cached, err := cache.GetCache(context.Background(), loaded.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated.Version, cached.Version)
+	require.Equal(t, updated.Mileage, cached.Mileage)
+	rpc.AssertExpectations(t)
+}
+
+func TestDeleteKeepsStaleCarOutOfCache(t *testing.T) {
+	stored := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 1}
+	deleted := *stored
+	deletedAt := time.Now()
+	deleted.DeletedAt = &deletedAt
+	deleted.Version++
+	rpc := new(mocks.CarRepository)
+	rpc.On("Get", mock.Anything, stored.ID).Return(stored, nil).Once()
+	rpc.On("Delete", mock.Anything, stored.ID).Return(&deleted, nil).Once()
+	s, cache := newTestCarEntity(rpc)
+
+	err := s.Delete(adminContext(), stored.ID)
+	require.NoError(t, err)
+	// a read which loaded the car before the deletion caches it late
+	require.NoError(t, cache.SetCache(context.Background(), stored))
+
+	_, err = s.Get(adminContext(), stored.ID)
+	require.ErrorIs(t, err, model.ErrCarNotFound)
+	cached, err := cache.GetCache(context.Background(), stored.ID)
+	require.NoError(t, err)
+	require.Equal(t, deleted.Version, cached.Version)
+	require.NotNil(t, cached.DeletedAt)
+	rpc.AssertExpectations(t)
+}
+
+func TestReconcileCache(t *testing.T) {
+	same := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 1}
+	changed := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2013, Version: 1}
+	deleted := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2014, Version: 1}
+	stored := *changed
+	stored.Color = "blue"
+	stored.Version++
+	rpc := new(mocks.CarRepository)
+	rpc.On("GetMany", mock.Anything, mock.MatchedBy(func(ids []uuid.UUID) bool { return len(ids) == 3 })).
+		Return(map[uuid.UUID]*model.Car{same.ID: same, changed.ID: &stored}, nil).Once()
+	s, cache := newTestCarEntity(rpc)
+	require.NoError(t, cache.SetCacheMany(context.Background(), []*model.Car{same, changed, deleted}))
+
+	result, err := s.ReconcileCache(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), result.Checked)
+	require.Equal(t, int64(2), result.Repaired)
+	_, err = cache.GetCache(context.Background(), same.ID)
+	require.NoError(t, err)
+	_, err = cache.GetCache(context.Background(), changed.ID)
+	require.Error(t, err)
+	_, err = cache.GetCache(context.Background(), deleted.ID)
+	require.Error(t, err)
+	rpc.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	rpc.AssertExpectations(t)
+}
+
+// failingSetCache is a cache where the cars can be read and dropped but not stored.
+type failingSetCache struct {
+	*repository.MemoryRepository
+}
+
+func (failingSetCache) SetCacheMany(context.Context, []*model.Car) error {
+	return errors.New("cache is unavailable")
+}
+
+func TestUpdateDropsCachedCarWhenCacheFails(t *testing.T) {
+	stored := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 1}
+	rpc := new(mocks.CarRepository)
+	rpc.On("Update", mock.Anything, mock.Anything, []string{model.CarFieldMileage}).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.Car).Version++
+	}).Return(nil).Once()
+	cache := failingSetCache{repository.NewMemoryRepository(&testConfig)}
+	require.NoError(t, cache.MemoryRepository.SetCache(context.Background(), stored))
+	s := newTestCarEntityWithCache(rpc, cache)
+
+	err := s.Update(adminContext(), &model.Car{ID: stored.ID, Mileage: 1000, Version: 1}, []string{model.CarFieldMileage})
+	require.NoError(t, err)
+	_, err = cache.GetCache(context.Background(), stored.ID)
+	require.ErrorIs(t, err, redis.Nil)
+	rpc.AssertExpectations(t)
+}
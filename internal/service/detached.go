@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// detachedContext carries the values of its parent, but is never canceled and has no deadline.
+type detachedContext struct {
+	parent context.Context
+}
+
+// DetachedContext returns a context with the values of ctx, e.g. the identity of the caller, which isn't canceled with ctx.
+// The work which has to be finished after the caller is gone runs with it, a timeout is added to it where the work may hang.
+// It does what context.WithoutCancel does since Go 1.21.
+func DetachedContext(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (c detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (c detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
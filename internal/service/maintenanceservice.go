@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+)
+
+// MaintenanceRepository is an interface that defines the methods on the maintenance records of cars.
+type MaintenanceRepository interface {
+	AddMaintenance(ctx context.Context, record *model.MaintenanceRecord) (*model.Car, error)
+	GetMaintenance(ctx context.Context, id uuid.UUID) (*model.MaintenanceRecord, error)
+	ListMaintenance(ctx context.Context, carID uuid.UUID) ([]*model.MaintenanceRecord, error)
+	CloseMaintenance(ctx context.Context, id uuid.UUID, closedAt time.Time) (*model.MaintenanceRecord, *model.Car, error)
+	DueForService(ctx context.Context, filter *model.ServiceDueFilter) ([]*model.ServiceDue, error)
+}
+
+// MaintenanceEntity represents the service that keeps the maintenance records of cars.
+type MaintenanceEntity struct {
+	repo MaintenanceRepository
+	cars *CarEntity
+	cfg  *config.Config
+}
+
+// NewMaintenanceEntity creates a new instance of the service, the cars are used to check access and to cache and record
+// the changes of the running state.
+func NewMaintenanceEntity(repo MaintenanceRepository, cars *CarEntity, cfg *config.Config) *MaintenanceEntity {
+	return &MaintenanceEntity{
+		repo: repo,
+		cars: cars,
+		cfg:  cfg,
+	}
+}
+
+// Add records maintenance of a car, non-admins may record only the maintenance of their own cars.
+// An open critical record stops the running car, the record and the change of the car are written at once.
+func (s *MaintenanceEntity) Add(ctx context.Context, record *model.MaintenanceRecord) error {
+	_, err := s.cars.Get(ctx, record.CarID)
+	if err != nil {
+		return fmt.Errorf("MaintenanceEntity-Add: error in method s.cars.Get: %w", err)
+	}
+	stopped, err := s.repo.AddMaintenance(ctx, record)
+	if err != nil {
+		return fmt.Errorf("MaintenanceEntity-Add: error in method s.repo.AddMaintenance: %w", err)
+	}
+	if stopped != nil {
+		err = s.cars.runningChanged(ctx, stopped)
+		if err != nil {
+			return fmt.Errorf("MaintenanceEntity-Add: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close marks an open maintenance record as done. The car runs again once the last open critical record is closed
+// if it was running before the first of them.
+func (s *MaintenanceEntity) Close(ctx context.Context, id uuid.UUID) (*model.MaintenanceRecord, error) {
+	record, err := s.repo.GetMaintenance(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("MaintenanceEntity-Close: error in method s.repo.GetMaintenance: %w", err)
+	}
+	_, err = s.cars.Get(ctx, record.CarID)
+	if err != nil {
+		return nil, fmt.Errorf("MaintenanceEntity-Close: error in method s.cars.Get: %w", err)
+	}
+	record, restarted, err := s.repo.CloseMaintenance(ctx, id, time.Now().UTC().Truncate(time.Millisecond))
+	if err != nil {
+		return nil, fmt.Errorf("MaintenanceEntity-Close: error in method s.repo.CloseMaintenance: %w", err)
+	}
+	if restarted != nil {
+		err = s.cars.runningChanged(ctx, restarted)
+		if err != nil {
+			return nil, fmt.Errorf("MaintenanceEntity-Close: %w", err)
+		}
+	}
+	return record, nil
+}
+
+// List retrieves the maintenance records of a car, non-admins may list only the records of their own cars.
+func (s *MaintenanceEntity) List(ctx context.Context, carID uuid.UUID) ([]*model.MaintenanceRecord, error) {
+	_, err := s.cars.Get(ctx, carID)
+	if err != nil {
+		return nil, fmt.Errorf("MaintenanceEntity-List: error in method s.cars.Get: %w", err)
+	}
+	records, err := s.repo.ListMaintenance(ctx, carID)
+	if err != nil {
+		return nil, fmt.Errorf("MaintenanceEntity-List: error in method s.repo.ListMaintenance: %w", err)
+	}
+	return records, nil
+}
+
+// DueForService retrieves the cars which are due for the routine service by the configured intervals,
+// non-admins get only their own cars.
+func (s *MaintenanceEntity) DueForService(ctx context.Context) ([]*model.ServiceDue, error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("MaintenanceEntity-DueForService: %w", model.ErrUnauthenticated)
+	}
+	filter := &model.ServiceDueFilter{
+		ServicedBefore:  time.Now().UTC().Add(-s.cfg.ServiceInterval),
+		MileageInterval: s.cfg.ServiceMileageInterval,
+	}
+	if !identity.Admin {
+		filter.OwnerID = identity.UserID
+	}
+	due, err := s.repo.DueForService(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("MaintenanceEntity-DueForService: error in method s.repo.DueForService: %w", err)
+	}
+	return due, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/distuurbia/firstTaskArtyom/internal/service/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseMaintenanceCachesRestartedCar(t *testing.T) {
+	stopped := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 2}
+	rpc := new(mocks.CarRepository)
+	rpc.On("Get", mock.Anything, stopped.ID).Return(stopped, nil).Once()
+	cars, cache := newTestCarEntity(rpc)
+	record := &model.MaintenanceRecord{ID: uuid.New(), CarID: stopped.ID, Kind: model.MaintenanceRepair, Critical: true, StoppedCar: true}
+	restarted := *stopped
+	restarted.IsRunning = true
+	restarted.Version++
+	repo := new(mocks.MaintenanceRepository)
+	repo.On("GetMaintenance", mock.Anything, record.ID).Return(record, nil).Once()
+	repo.On("CloseMaintenance", mock.Anything, record.ID, mock.Anything).Return(record, &restarted, nil).Once()
+	s := NewMaintenanceEntity(repo, cars, &testConfig)
+
+	_, err := s.Close(adminContext(), record.ID)
+	require.NoError(t, err)
+	cached, err := cache.GetCache(context.Background(), stopped.ID)
+	require.NoError(t, err)
+	require.True(t, cached.IsRunning)
+	require.Equal(t, restarted.Version, cached.Version)
+	rpc.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateRunningUnderMaintenance(t *testing.T) {
+	stored := &model.Car{ID: uuid.New(), Brand: "Tesla", ProductionYear: 2012, Version: 2}
+	rpc := new(mocks.CarRepository)
+	rpc.On("Get", mock.Anything, stored.ID).Return(stored, nil).Once()
+	rpc.On("Update", mock.Anything, mock.Anything, []string{model.CarFieldIsRunning}).Return(model.ErrCarUnderMaintenance).Once()
+	s, cache := newTestCarEntity(rpc)
+
+	err := s.Update(adminContext(), &model.Car{ID: stored.ID, IsRunning: true, Version: 2}, []string{model.CarFieldIsRunning})
+	require.ErrorIs(t, err, model.ErrCarUnderMaintenance)
+	_, err = cache.GetCache(context.Background(), stored.ID)
+	require.Error(t, err)
+	rpc.AssertExpectations(t)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/google/uuid"
+)
+
+// ReservationRepository is an interface that defines the methods on the reservations of cars.
+type ReservationRepository interface {
+	CreateReservation(ctx context.Context, reservation *model.Reservation) error
+	GetReservation(ctx context.Context, id uuid.UUID) (*model.Reservation, error)
+	CancelReservation(ctx context.Context, id uuid.UUID, canceledAt time.Time) (*model.Reservation, error)
+	ListReservations(ctx context.Context, filter *model.ReservationFilter) ([]*model.Reservation, error)
+}
+
+// ReservationEntity represents the service that books cars for users.
+// Any user may reserve any car, the reservations are visible to the user who made them, to the owner of the car and to admins.
+type ReservationEntity struct {
+	repo ReservationRepository
+	cars CarRepository
+}
+
+// NewReservationEntity creates a new instance of the service, the cars are read to check that a reserved car exists.
+func NewReservationEntity(repo ReservationRepository, cars CarRepository) *ReservationEntity {
+	return &ReservationEntity{
+		repo: repo,
+		cars: cars,
+	}
+}
+
+// Create reserves a car for the caller, model.ErrReservationConflict is returned if the car is already reserved for a part of the time.
+func (s *ReservationEntity) Create(ctx context.Context, reservation *model.Reservation) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("ReservationEntity-Create: %w", model.ErrUnauthenticated)
+	}
+	_, err := s.cars.Get(ctx, reservation.CarID)
+	if err != nil {
+		return fmt.Errorf("ReservationEntity-Create: error in method s.cars.Get: %w", err)
+	}
+	reservation.UserID = identity.UserID
+	reservation.CreatedAt = time.Now().UTC().Truncate(time.Millisecond)
+	reservation.CanceledAt = nil
+	err = s.repo.CreateReservation(ctx, reservation)
+	if err != nil {
+		return fmt.Errorf("ReservationEntity-Create: error in method s.repo.CreateReservation: %w", err)
+	}
+	return nil
+}
+
+// Cancel cancels an active reservation, it may be canceled by the user who made it, by the owner of the car and by admins.
+func (s *ReservationEntity) Cancel(ctx context.Context, id uuid.UUID) (*model.Reservation, error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("ReservationEntity-Cancel: %w", model.ErrUnauthenticated)
+	}
+	reservation, err := s.repo.GetReservation(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationEntity-Cancel: error in method s.repo.GetReservation: %w", err)
+	}
+	if !identity.Admin && reservation.UserID != identity.UserID {
+		car, errCar := s.cars.Get(ctx, reservation.CarID)
+		if errCar != nil {
+			return nil, fmt.Errorf("ReservationEntity-Cancel: error in method s.cars.Get: %w", errCar)
+		}
+		if car.OwnerID != identity.UserID {
+			return nil, fmt.Errorf("ReservationEntity-Cancel: %w", model.ErrPermissionDenied)
+		}
+	}
+	reservation, err = s.repo.CancelReservation(ctx, id, time.Now().UTC().Truncate(time.Millisecond))
+	if err != nil {
+		return nil, fmt.Errorf("ReservationEntity-Cancel: error in method s.repo.CancelReservation: %w", err)
+	}
+	return reservation, nil
+}
+
+// ListByCar retrieves the reservations of a car, users who neither own the car nor are admins get only their own reservations.
+func (s *ReservationEntity) ListByCar(ctx context.Context, carID uuid.UUID, includeCanceled bool) ([]*model.Reservation, error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("ReservationEntity-ListByCar: %w", model.ErrUnauthenticated)
+	}
+	car, err := s.cars.Get(ctx, carID)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationEntity-ListByCar: error in method s.cars.Get: %w", err)
+	}
+	filter := &model.ReservationFilter{CarID: carID, IncludeCanceled: includeCanceled}
+	if !identity.Admin && car.OwnerID != identity.UserID {
+		filter.UserID = identity.UserID
+	}
+	reservations, err := s.repo.ListReservations(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("ReservationEntity-ListByCar: error in method s.repo.ListReservations: %w", err)
+	}
+	return reservations, nil
+}
+
+// ListByUser retrieves the reservations made by a user, uuid.Nil stands for the caller. Only admins may list the reservations of other users.
+func (s *ReservationEntity) ListByUser(ctx context.Context, userID uuid.UUID, includeCanceled bool) ([]*model.Reservation, error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("ReservationEntity-ListByUser: %w", model.ErrUnauthenticated)
+	}
+	if userID == uuid.Nil {
+		userID = identity.UserID
+	}
+	if !identity.Admin && userID != identity.UserID {
+		return nil, fmt.Errorf("ReservationEntity-ListByUser: %w", model.ErrPermissionDenied)
+	}
+	reservations, err := s.repo.ListReservations(ctx, &model.ReservationFilter{UserID: userID, IncludeCanceled: includeCanceled})
+	if err != nil {
+		return nil, fmt.Errorf("ReservationEntity-ListByUser: error in method s.repo.ListReservations: %w", err)
+	}
+	return reservations, nil
+}
@@ -0,0 +1,117 @@
+// Package validation provides the validator with the custom rules used by the model tags.
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// now returns the current time, tests replace it to pin the current year.
+var now = time.Now
+
+// New creates a validator which knows the custom rules of the model tags, the production year rule follows cfg.
+func New(cfg *config.Config) *validator.Validate {
+	v := validator.New()
+	err := v.RegisterValidation("vin", validateVIN)
+	if err != nil {
+		panic(fmt.Sprintf("validation: failed to register the vin rule: %v", err))
+	}
+	err = v.RegisterValidation("productionyear", productionYearRule(cfg))
+	if err != nil {
+		panic(fmt.Sprintf("validation: failed to register the productionyear rule: %v", err))
+	}
+	err = v.RegisterValidation("labels", validateLabels)
+	if err != nil {
+		panic(fmt.Sprintf("validation: failed to register the labels rule: %v", err))
+	}
+	return v
+}
+
+// validateLabels checks that a car has at most model.MaxCarLabels labels and that their keys and values are valid.
+func validateLabels(fl validator.FieldLevel) bool {
+	labels, ok := fl.Field().Interface().(map[string]string)
+	if !ok {
+		return false
+	}
+	if len(labels) > model.MaxCarLabels {
+		return false
+	}
+	for key, value := range labels {
+		if !model.ValidLabelKey(key) || !model.ValidLabelValue(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// productionYearRule checks that a car is produced at most cfg.ProductionYearMaxAge years before the current year
+// and at most cfg.ProductionYearMaxLead years after it. If the field belongs to a struct with a brand which has
+// a first production year in cfg, the car must not be older than the brand too.
+func productionYearRule(cfg *config.Config) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		year := fl.Field().Int()
+		currentYear := int64(now().Year())
+		if year < currentYear-cfg.ProductionYearMaxAge || year > currentYear+cfg.ProductionYearMaxLead {
+			return false
+		}
+		parent := fl.Parent()
+		if parent.Kind() == reflect.Ptr {
+			parent = parent.Elem()
+		}
+		if parent.Kind() != reflect.Struct {
+			return true
+		}
+		brand := parent.FieldByName("Brand")
+		if brand.Kind() != reflect.String {
+			return true
+		}
+		firstYear, ok := cfg.BrandFirstProductionYears.Year(brand.String())
+		return !ok || year >= firstYear
+	}
+}
+
+// validateVIN checks a vehicle identification number by its ISO 3779 check digit.
+func validateVIN(fl validator.FieldLevel) bool {
+	return ValidVIN(fl.Field().String())
+}
+
+// vinWeights are the weights of the VIN positions in the check digit sum, the check digit itself has weight 0.
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// vinLetterValues are the numbers the VIN letters stand for in the check digit sum, I, O and Q are not allowed.
+var vinLetterValues = map[rune]int{
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+// ValidVIN reports whether vin has 17 allowed characters and the 9th of them is the right check digit.
+func ValidVIN(vin string) bool {
+	if len(vin) != len(vinWeights) {
+		return false
+	}
+	sum := 0
+	for i, char := range strings.ToUpper(vin) {
+		var value int
+		switch {
+		case char >= '0' && char <= '9':
+			value = int(char - '0')
+		case vinLetterValues[char] != 0:
+			value = vinLetterValues[char]
+		default:
+			return false
+		}
+		sum += value * vinWeights[i]
+	}
+	checkDigit := byte('0' + sum%11)
+	if sum%11 == 10 {
+		checkDigit = 'X'
+	}
+	return strings.ToUpper(vin)[8] == checkDigit
+}
@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/distuurbia/firstTaskArtyom/internal/config"
+	"github.com/distuurbia/firstTaskArtyom/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = config.Config{
+	ProductionYearMaxAge:      100,
+	ProductionYearMaxLead:     1,
+	BrandFirstProductionYears: config.BrandYears{"tesla": 2008},
+}
+
+func TestValidVIN(t *testing.T) {
+	require.True(t, ValidVIN("1M8GDM9AXKP042788"))
+	require.True(t, ValidVIN("11111111111111111"))
+	require.True(t, ValidVIN("1m8gdm9axkp042788"))
+	require.False(t, ValidVIN("1M8GDM9A1KP042788"))
+	require.False(t, ValidVIN("1M8GDM9AXKP04278"))
+	require.False(t, ValidVIN("1M8GDM9AXKP0427O8"))
+}
+
+func TestVINRule(t *testing.T) {
+	v := New(&testConfig)
+	require.NoError(t, v.Var("1M8GDM9AXKP042788", "vin"))
+	require.Error(t, v.Var("1M8GDM9A1KP042788", "vin"))
+	require.NoError(t, v.Var("", "omitempty,vin"))
+}
+
+func TestProductionYearRule(t *testing.T) {
+	now = func() time.Time { return time.Date(2030, time.March, 1, 0, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+	v := New(&testConfig)
+	require.NoError(t, v.Var(int64(1930), "productionyear"))
+	require.NoError(t, v.Var(int64(2031), "productionyear"))
+	require.Error(t, v.Var(int64(1929), "productionyear"))
+	require.Error(t, v.Var(int64(2032), "productionyear"))
+}
+
+func TestProductionYearRuleBrand(t *testing.T) {
+	v := New(&testConfig)
+	require.NoError(t, v.Struct(&model.Car{Brand: "Audi", ProductionYear: 2005}))
+	require.NoError(t, v.Struct(&model.Car{Brand: "Tesla", ProductionYear: 2008}))
+	require.Error(t, v.Struct(&model.Car{Brand: "TESLA", ProductionYear: 2005}))
+	require.NoError(t, v.StructPartial(&model.Car{Brand: "Tesla", ProductionYear: 2005}, model.CarFieldBrand))
+}
+
+func TestLabelsRule(t *testing.T) {
+	v := New(&testConfig)
+	require.NoError(t, v.Struct(&model.Car{Brand: "Audi", ProductionYear: 2005}))
+	require.NoError(t, v.Struct(&model.Car{Brand: "Audi", ProductionYear: 2005, Labels: map[string]string{"region": "minsk", "team/pool": ""}}))
+	require.Error(t, v.Struct(&model.Car{Brand: "Audi", ProductionYear: 2005, Labels: map[string]string{"app.io/name": "car"}}))
+	require.Error(t, v.Struct(&model.Car{Brand: "Audi", ProductionYear: 2005, Labels: map[string]string{"region": "minsk!"}}))
+	labels := make(map[string]string)
+	for i := 0; i <= model.MaxCarLabels; i++ {
+		labels[fmt.Sprintf("label%d", i)] = "on"
+	}
+	require.Error(t, v.Struct(&model.Car{Brand: "Audi", ProductionYear: 2005, Labels: labels}))
+}
+
+func TestBrandYearsUnmarshalText(t *testing.T) {
+	var years config.BrandYears
+	require.NoError(t, years.UnmarshalText([]byte("Tesla:2008, Audi : 1910,")))
+	year, ok := years.Year("tesla")
+	require.True(t, ok)
+	require.Equal(t, int64(2008), year)
+	year, ok = years.Year("AUDI")
+	require.True(t, ok)
+	require.Equal(t, int64(1910), year)
+	require.Error(t, years.UnmarshalText([]byte("Tesla")))
+	require.Error(t, years.UnmarshalText([]byte("Tesla:new")))
+}
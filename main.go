@@ -20,9 +20,9 @@ import (
 	"github.com/go-redis/redis/v8"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/distuurbia/firstTaskArtyom/internal/validation"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"gopkg.in/go-playground/validator.v9"
 )
 
 const (
@@ -62,6 +62,46 @@ func connectRedis(cfg *config.Config) *redis.Client {
 	return client
 }
 
+// setupCache creates the cache of cars, the bus of their changes and the store of idempotency keys of cfg.CacheBackend,
+// Redis is connected to only if it is the backend. The returned function closes the connection.
+func setupCache(cfg *config.Config) (service.RedisCarRepository, service.CarEventBus, interceptor.IdempotencyStore, func()) {
+	var (
+		carCache service.RedisCarRepository
+		events   service.CarEventBus
+		store    interceptor.IdempotencyStore
+		closeFn  = func() {}
+	)
+	switch cfg.CacheBackend {
+	case config.CacheBackendRedis:
+		redisClient := connectRedis(cfg)
+		closeFn = func() {
+			errClose := redisClient.Close()
+			if errClose != nil {
+				log.Fatalf("Failed to disconnect from Redis: %v", errClose)
+			}
+		}
+		repoRedis := repository.NewRedisRepository(redisClient, cfg)
+		tieredCache := repository.NewTieredCarCache(repoRedis, cfg)
+		go tieredCache.ListenInvalidations(context.Background())
+		carCache, events, store = tieredCache, repoRedis, repoRedis
+	case config.CacheBackendMemory:
+		repoMemory := repository.NewMemoryRepository(cfg)
+		carCache, events, store = repoMemory, repoMemory, repoMemory
+	case config.CacheBackendNoop:
+		// the changes and the idempotency keys still need a place, the instance is alone without Redis
+		repoMemory := repository.NewMemoryRepository(cfg)
+		carCache, events, store = repository.NewNoopCarCache(), repoMemory, repoMemory
+	default:
+		log.Fatalf("Unknown cache backend %q", cfg.CacheBackend)
+	}
+	if cfg.CacheDegradedMode {
+		carCache = repository.NewDegradedCarCache(carCache)
+		events = repository.NewDegradedEventBus(events)
+		store = repository.NewDegradedIdempotencyStore(store)
+	}
+	return carCache, events, store, closeFn
+}
+
 //nolint:funlen //Disabled because project have too many connections.
 func main() {
 	var (
@@ -74,20 +114,14 @@ func main() {
 		log.Fatalf("Failed to parse config: %v", err)
 	}
 
-	redisClient := connectRedis(&cfg)
-	defer func() {
-		errClose := redisClient.Close()
-		if errClose != nil {
-			log.Fatalf("Failed to disconnect from Redis: %v", errClose)
-		}
-	}()
-	repoRedis := repository.NewRedisRepository(redisClient)
+	carCache, events, idempotencyStore, closeCache := setupCache(&cfg)
+	defer closeCache()
 	fmt.Print("Choose database:\n 1)Postgres\n 2)MongoDB\n")
 	_, err := fmt.Scan(&database)
 	if err != nil {
 		fmt.Printf("Failed to read: %v", err)
 	}
-	v := validator.New()
+	v := validation.New(&cfg)
 	switch database {
 	case PostgresDatabase:
 		pool, errPGX := connectPostgres(&cfg)
@@ -97,9 +131,14 @@ func main() {
 		defer pool.Close()
 
 		repoPostgres := repository.NewPgRepository(pool)
-		carService := service.NewCarEntity(repoPostgres, repoRedis)
+		carService := service.NewCarEntity(repoPostgres, carCache, repoPostgres, events, repoPostgres, &cfg)
+		go carService.ReconcileCacheEvery(context.Background(), cfg.CacheReconcileInterval)
 		userService := service.NewUserEntity(repoPostgres, &cfg)
-		handl = handler.NewGRPCHandler(carService, userService, v)
+		imageService := service.NewCarImageEntity(repoPostgres, carService, &cfg)
+		maintenanceService := service.NewMaintenanceEntity(repoPostgres, carService, &cfg)
+		reservationService := service.NewReservationEntity(repoPostgres, repoPostgres)
+		brandService := service.NewBrandEntity(repoPostgres, carCache)
+		handl = handler.NewGRPCHandler(carService, userService, imageService, maintenanceService, reservationService, brandService, v)
 
 	case MongoDBDatabase:
 		mongoClient, errMongo := connectMongo(&cfg)
@@ -114,9 +153,18 @@ func main() {
 		}()
 
 		repoMongo := repository.NewMongoRepository(mongoClient)
-		carService := service.NewCarEntity(repoMongo, repoRedis)
+		errMongo = repoMongo.EnsureIndexes(context.Background())
+		if errMongo != nil {
+			log.Fatalf("Failed to create MongoDB indexes: %v", errMongo)
+		}
+		carService := service.NewCarEntity(repoMongo, carCache, repoMongo, events, repoMongo, &cfg)
+		go carService.ReconcileCacheEvery(context.Background(), cfg.CacheReconcileInterval)
 		userService := service.NewUserEntity(repoMongo, &cfg)
-		handl = handler.NewGRPCHandler(carService, userService, v)
+		imageService := service.NewCarImageEntity(repoMongo, carService, &cfg)
+		maintenanceService := service.NewMaintenanceEntity(repoMongo, carService, &cfg)
+		reservationService := service.NewReservationEntity(repoMongo, repoMongo)
+		brandService := service.NewBrandEntity(repoMongo, carCache)
+		handl = handler.NewGRPCHandler(carService, userService, imageService, maintenanceService, reservationService, brandService, v)
 
 	default:
 		//nolint:gocritic
@@ -127,12 +175,17 @@ func main() {
 		log.Fatalf("cannot connect listener: %s", err)
 	}
 	customInterceptor := interceptor.NewCustomInterceptor(&cfg)
+	idempotencyInterceptor := interceptor.NewIdempotencyInterceptor(idempotencyStore, &cfg)
 	serverRegistrar := grpc.NewServer(
-		grpc.UnaryInterceptor(customInterceptor.UnaryInterceptor),
+		grpc.ChainUnaryInterceptor(customInterceptor.UnaryInterceptor, idempotencyInterceptor.UnaryInterceptor),
+		grpc.StreamInterceptor(customInterceptor.StreamInterceptor),
 	)
 	proto_services.RegisterCarServiceServer(serverRegistrar, handl)
 	proto_services.RegisterUserServiceServer(serverRegistrar, handl)
 	proto_services.RegisterImageServiceServer(serverRegistrar, handl)
+	proto_services.RegisterMaintenanceServiceServer(serverRegistrar, handl)
+	proto_services.RegisterReservationServiceServer(serverRegistrar, handl)
+	proto_services.RegisterBrandServiceServer(serverRegistrar, handl)
 	err = serverRegistrar.Serve(lis)
 	if err != nil {
 		log.Fatalf("cannot serve: %s", err)
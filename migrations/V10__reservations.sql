@@ -0,0 +1,15 @@
+-- Reservations of cars, the active reservations of a car must not overlap
+create extension if not exists btree_gist;
+create table reservation (
+	id uuid,
+	carid uuid not null references car (id) on delete cascade,
+	userid uuid not null,
+	startsat timestamptz not null,
+	endsat timestamptz not null,
+	createdat timestamptz not null,
+	canceledat timestamptz,
+	primary key (id),
+	constraint reservation_window_check check (endsat > startsat),
+	constraint reservation_no_overlap exclude using gist (carid with =, tstzrange(startsat, endsat) with &&) where (canceledat is null)
+);
+create index reservation_userid_startsat_idx on reservation (userid, startsat);
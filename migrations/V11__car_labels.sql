@@ -0,0 +1,3 @@
+-- Labels of cars, the GIN index serves the containment and key existence checks of label selectors
+alter table car add column labels jsonb not null default '{}';
+create index car_labels_idx on car using gin (labels);
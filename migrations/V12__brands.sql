@@ -0,0 +1,15 @@
+-- Catalog of brands, a brand is found by the lowercased key of its name or of any of its aliases
+create table brand (
+	id uuid,
+	name VARCHAR(30) not null,
+	aliases VARCHAR(30)[] not null default '{}',
+	primary key (id)
+);
+create table brandkey (
+	key VARCHAR(30),
+	brandid uuid not null references brand (id) on delete cascade,
+	primary key (key)
+);
+create index brandkey_brandid_idx on brandkey (brandid);
+alter table car add column brandid uuid references brand (id);
+create index car_brandid_idx on car (brandid);
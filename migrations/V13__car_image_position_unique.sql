@@ -0,0 +1,7 @@
+-- The positions of the images of a car are unique, the images which got the same position from concurrent uploads are renumbered first.
+-- The constraint is checked at the end of each statement, so the images can be shifted by one statement when one becomes the primary one.
+update carimage set position = numbered.position
+from (select id, row_number() over (partition by carid order by position, createdat) - 1 as position from carimage) numbered
+where carimage.id = numbered.id and carimage.position <> numbered.position;
+drop index carimage_carid_position_idx;
+alter table carimage add constraint carimage_carid_position_key unique (carid, position) deferrable initially immediate;
@@ -0,0 +1,5 @@
+-- A critical record which stopped a running car is marked, the car runs again when the open critical record holding the mark is closed last.
+-- Closing the last open critical record used to set any car running, so the first open critical record of each car gets the mark.
+alter table maintenance add column stoppedcar BOOLEAN not null default false;
+update maintenance set stoppedcar = true
+where id in (select distinct on (carid) id from maintenance where critical and closedat is null order by carid, performedat, id);
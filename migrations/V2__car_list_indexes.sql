@@ -0,0 +1,4 @@
+-- Indexes for listing cars page by page
+create index car_brand_id_idx on car (brand, id);
+create index car_productionyear_id_idx on car (productionyear, id);
+create index car_isrunning_idx on car (isrunning);
@@ -0,0 +1,3 @@
+-- Cars belong to the user who created them
+alter table car add column ownerid uuid;
+create index car_ownerid_id_idx on car (ownerid, id);
@@ -0,0 +1,2 @@
+-- Version of a car for optimistic concurrency control
+alter table car add column version bigint not null default 1;
@@ -0,0 +1,3 @@
+-- Deleted cars are kept as tombstones until they are purged
+alter table car add column deletedat timestamptz;
+create index car_deletedat_idx on car (deletedat) where deletedat is not null;
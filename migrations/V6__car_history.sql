@@ -0,0 +1,12 @@
+-- History of the changes made to cars
+create table carhistory (
+	id uuid,
+	carid uuid not null,
+	actorid uuid,
+	action VARCHAR(10) not null,
+	changedat timestamptz not null,
+	before jsonb,
+	after jsonb,
+	primary key (id)
+);
+create index carhistory_carid_changedat_id_idx on carhistory (carid, changedat, id);
@@ -0,0 +1,7 @@
+-- Details of cars, a VIN belongs to one car only
+alter table car add column vin VARCHAR(17);
+alter table car add column model VARCHAR(50) not null default '';
+alter table car add column mileage bigint not null default 0;
+alter table car add column color VARCHAR(30) not null default '';
+alter table car add column price bigint not null default 0;
+create unique index car_vin_idx on car (vin);
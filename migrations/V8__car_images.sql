@@ -0,0 +1,12 @@
+-- Metadata of the uploaded images of cars, the image at position 0 is the primary one
+create table carimage (
+	id uuid,
+	carid uuid not null references car (id) on delete cascade,
+	contenttype VARCHAR(30) not null,
+	size bigint not null,
+	checksum CHAR(64) not null,
+	position INTEGER not null,
+	createdat timestamptz not null,
+	primary key (id)
+);
+create index carimage_carid_position_idx on carimage (carid, position);
@@ -0,0 +1,15 @@
+-- Maintenance records of cars, a record is open while closedat is null
+create table maintenance (
+	id uuid,
+	carid uuid not null references car (id) on delete cascade,
+	kind VARCHAR(20) not null,
+	performedat timestamptz not null,
+	mileage bigint not null default 0,
+	cost bigint not null default 0,
+	notes VARCHAR(1000) not null default '',
+	critical BOOLEAN not null default false,
+	closedat timestamptz,
+	primary key (id)
+);
+create index maintenance_carid_performedat_idx on maintenance (carid, performedat);
+create index maintenance_open_critical_idx on maintenance (carid) where critical and closedat is null;
@@ -9,6 +9,9 @@ package proto_services
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,15 +23,281 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CarSortField int32
+
+const (
+	CarSortField_SORT_BY_ID              CarSortField = 0
+	CarSortField_SORT_BY_BRAND           CarSortField = 1
+	CarSortField_SORT_BY_PRODUCTION_YEAR CarSortField = 2
+)
+
+// Enum value maps for CarSortField.
+var (
+	CarSortField_name = map[int32]string{
+		0: "SORT_BY_ID",
+		1: "SORT_BY_BRAND",
+		2: "SORT_BY_PRODUCTION_YEAR",
+	}
+	CarSortField_value = map[string]int32{
+		"SORT_BY_ID":              0,
+		"SORT_BY_BRAND":           1,
+		"SORT_BY_PRODUCTION_YEAR": 2,
+	}
+)
+
+func (x CarSortField) Enum() *CarSortField {
+	p := new(CarSortField)
+	*p = x
+	return p
+}
+
+func (x CarSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CarSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_proto_enumTypes[0].Descriptor()
+}
+
+func (CarSortField) Type() protoreflect.EnumType {
+	return &file_services_proto_enumTypes[0]
+}
+
+func (x CarSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CarSortField.Descriptor instead.
+func (CarSortField) EnumDescriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{0}
+}
+
+type CarChangeAction int32
+
+const (
+	CarChangeAction_CAR_CHANGE_ACTION_UNSPECIFIED CarChangeAction = 0
+	CarChangeAction_CAR_CREATED                   CarChangeAction = 1
+	CarChangeAction_CAR_UPDATED                   CarChangeAction = 2
+	CarChangeAction_CAR_DELETED                   CarChangeAction = 3
+	CarChangeAction_CAR_RESTORED                  CarChangeAction = 4
+)
+
+// Enum value maps for CarChangeAction.
+var (
+	CarChangeAction_name = map[int32]string{
+		0: "CAR_CHANGE_ACTION_UNSPECIFIED",
+		1: "CAR_CREATED",
+		2: "CAR_UPDATED",
+		3: "CAR_DELETED",
+		4: "CAR_RESTORED",
+	}
+	CarChangeAction_value = map[string]int32{
+		"CAR_CHANGE_ACTION_UNSPECIFIED": 0,
+		"CAR_CREATED":                   1,
+		"CAR_UPDATED":                   2,
+		"CAR_DELETED":                   3,
+		"CAR_RESTORED":                  4,
+	}
+)
+
+func (x CarChangeAction) Enum() *CarChangeAction {
+	p := new(CarChangeAction)
+	*p = x
+	return p
+}
+
+func (x CarChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CarChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_proto_enumTypes[1].Descriptor()
+}
+
+func (CarChangeAction) Type() protoreflect.EnumType {
+	return &file_services_proto_enumTypes[1]
+}
+
+func (x CarChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CarChangeAction.Descriptor instead.
+func (CarChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{1}
+}
+
+type CarDataFormat int32
+
+const (
+	CarDataFormat_CAR_DATA_FORMAT_CSV    CarDataFormat = 0
+	CarDataFormat_CAR_DATA_FORMAT_NDJSON CarDataFormat = 1
+)
+
+// Enum value maps for CarDataFormat.
+var (
+	CarDataFormat_name = map[int32]string{
+		0: "CAR_DATA_FORMAT_CSV",
+		1: "CAR_DATA_FORMAT_NDJSON",
+	}
+	CarDataFormat_value = map[string]int32{
+		"CAR_DATA_FORMAT_CSV":    0,
+		"CAR_DATA_FORMAT_NDJSON": 1,
+	}
+)
+
+func (x CarDataFormat) Enum() *CarDataFormat {
+	p := new(CarDataFormat)
+	*p = x
+	return p
+}
+
+func (x CarDataFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CarDataFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_proto_enumTypes[2].Descriptor()
+}
+
+func (CarDataFormat) Type() protoreflect.EnumType {
+	return &file_services_proto_enumTypes[2]
+}
+
+func (x CarDataFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CarDataFormat.Descriptor instead.
+func (CarDataFormat) EnumDescriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{2}
+}
+
+type CarImportStatus int32
+
+const (
+	CarImportStatus_CAR_IMPORT_STATUS_UNSPECIFIED CarImportStatus = 0
+	CarImportStatus_CAR_IMPORTED                  CarImportStatus = 1
+	CarImportStatus_CAR_IMPORT_SKIPPED            CarImportStatus = 2
+	CarImportStatus_CAR_IMPORT_FAILED             CarImportStatus = 3
+)
+
+// Enum value maps for CarImportStatus.
+var (
+	CarImportStatus_name = map[int32]string{
+		0: "CAR_IMPORT_STATUS_UNSPECIFIED",
+		1: "CAR_IMPORTED",
+		2: "CAR_IMPORT_SKIPPED",
+		3: "CAR_IMPORT_FAILED",
+	}
+	CarImportStatus_value = map[string]int32{
+		"CAR_IMPORT_STATUS_UNSPECIFIED": 0,
+		"CAR_IMPORTED":                  1,
+		"CAR_IMPORT_SKIPPED":            2,
+		"CAR_IMPORT_FAILED":             3,
+	}
+)
+
+func (x CarImportStatus) Enum() *CarImportStatus {
+	p := new(CarImportStatus)
+	*p = x
+	return p
+}
+
+func (x CarImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CarImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_proto_enumTypes[3].Descriptor()
+}
+
+func (CarImportStatus) Type() protoreflect.EnumType {
+	return &file_services_proto_enumTypes[3]
+}
+
+func (x CarImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CarImportStatus.Descriptor instead.
+func (CarImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{3}
+}
+
+type MaintenanceKind int32
+
+const (
+	MaintenanceKind_MAINTENANCE_KIND_UNSPECIFIED MaintenanceKind = 0
+	MaintenanceKind_MAINTENANCE_SERVICE          MaintenanceKind = 1
+	MaintenanceKind_MAINTENANCE_REPAIR           MaintenanceKind = 2
+	MaintenanceKind_MAINTENANCE_INSPECTION       MaintenanceKind = 3
+	MaintenanceKind_MAINTENANCE_TIRES            MaintenanceKind = 4
+)
+
+// Enum value maps for MaintenanceKind.
+var (
+	MaintenanceKind_name = map[int32]string{
+		0: "MAINTENANCE_KIND_UNSPECIFIED",
+		1: "MAINTENANCE_SERVICE",
+		2: "MAINTENANCE_REPAIR",
+		3: "MAINTENANCE_INSPECTION",
+		4: "MAINTENANCE_TIRES",
+	}
+	MaintenanceKind_value = map[string]int32{
+		"MAINTENANCE_KIND_UNSPECIFIED": 0,
+		"MAINTENANCE_SERVICE":          1,
+		"MAINTENANCE_REPAIR":           2,
+		"MAINTENANCE_INSPECTION":       3,
+		"MAINTENANCE_TIRES":            4,
+	}
+)
+
+func (x MaintenanceKind) Enum() *MaintenanceKind {
+	p := new(MaintenanceKind)
+	*p = x
+	return p
+}
+
+func (x MaintenanceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_proto_enumTypes[4].Descriptor()
+}
+
+func (MaintenanceKind) Type() protoreflect.EnumType {
+	return &file_services_proto_enumTypes[4]
+}
+
+func (x MaintenanceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceKind.Descriptor instead.
+func (MaintenanceKind) EnumDescriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{4}
+}
+
 type Car struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID             *UUID  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Brand          string `protobuf:"bytes,2,opt,name=Brand,proto3" json:"Brand,omitempty"`
-	ProductionYear int64  `protobuf:"varint,3,opt,name=ProductionYear,proto3" json:"ProductionYear,omitempty"`
-	IsRunning      bool   `protobuf:"varint,4,opt,name=IsRunning,proto3" json:"IsRunning,omitempty"`
+	ID             *UUID             `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Brand          string            `protobuf:"bytes,2,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	ProductionYear int64             `protobuf:"varint,3,opt,name=ProductionYear,proto3" json:"ProductionYear,omitempty"`
+	IsRunning      bool              `protobuf:"varint,4,opt,name=IsRunning,proto3" json:"IsRunning,omitempty"`
+	OwnerID        *UUID             `protobuf:"bytes,5,opt,name=OwnerID,proto3" json:"OwnerID,omitempty"`
+	Version        int64             `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+	VIN            string            `protobuf:"bytes,7,opt,name=VIN,proto3" json:"VIN,omitempty"`
+	Model          string            `protobuf:"bytes,8,opt,name=Model,proto3" json:"Model,omitempty"`
+	Mileage        int64             `protobuf:"varint,9,opt,name=Mileage,proto3" json:"Mileage,omitempty"`
+	Color          string            `protobuf:"bytes,10,opt,name=Color,proto3" json:"Color,omitempty"`
+	Price          int64             `protobuf:"varint,11,opt,name=Price,proto3" json:"Price,omitempty"`
+	Labels         map[string]string `protobuf:"bytes,12,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BrandID        *UUID             `protobuf:"bytes,13,opt,name=BrandID,proto3" json:"BrandID,omitempty"`
 }
 
 func (x *Car) Reset() {
@@ -91,6 +360,69 @@ func (x *Car) GetIsRunning() bool {
 	return false
 }
 
+func (x *Car) GetOwnerID() *UUID {
+	if x != nil {
+		return x.OwnerID
+	}
+	return nil
+}
+
+func (x *Car) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Car) GetVIN() string {
+	if x != nil {
+		return x.VIN
+	}
+	return ""
+}
+
+func (x *Car) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Car) GetMileage() int64 {
+	if x != nil {
+		return x.Mileage
+	}
+	return 0
+}
+
+func (x *Car) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Car) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Car) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Car) GetBrandID() *UUID {
+	if x != nil {
+		return x.BrandID
+	}
+	return nil
+}
+
 type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -269,7 +601,8 @@ type UploadImageRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Img []byte `protobuf:"bytes,1,opt,name=img,proto3" json:"img,omitempty"`
+	Img   []byte `protobuf:"bytes,1,opt,name=img,proto3" json:"img,omitempty"`
+	CarID *UUID  `protobuf:"bytes,2,opt,name=carID,proto3" json:"carID,omitempty"`
 }
 
 func (x *UploadImageRequest) Reset() {
@@ -311,10 +644,19 @@ func (x *UploadImageRequest) GetImg() []byte {
 	return nil
 }
 
+func (x *UploadImageRequest) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
 type UploadImageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Image *CarImage `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
 }
 
 func (x *UploadImageResponse) Reset() {
@@ -349,16 +691,30 @@ func (*UploadImageResponse) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{5}
 }
 
-type UUID struct {
+func (x *UploadImageResponse) GetImage() *CarImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type CarImage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	ID          *UUID                  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CarID       *UUID                  `protobuf:"bytes,2,opt,name=carID,proto3" json:"carID,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Size        int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum    string                 `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Order       int64                  `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
+	ImgName     string                 `protobuf:"bytes,7,opt,name=imgName,proto3" json:"imgName,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *UUID) Reset() {
-	*x = UUID{}
+func (x *CarImage) Reset() {
+	*x = CarImage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -366,13 +722,13 @@ func (x *UUID) Reset() {
 	}
 }
 
-func (x *UUID) String() string {
+func (x *CarImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UUID) ProtoMessage() {}
+func (*CarImage) ProtoMessage() {}
 
-func (x *UUID) ProtoReflect() protoreflect.Message {
+func (x *CarImage) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -384,28 +740,77 @@ func (x *UUID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UUID.ProtoReflect.Descriptor instead.
-func (*UUID) Descriptor() ([]byte, []int) {
+// Deprecated: Use CarImage.ProtoReflect.Descriptor instead.
+func (*CarImage) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UUID) GetValue() string {
+func (x *CarImage) GetID() *UUID {
 	if x != nil {
-		return x.Value
+		return x.ID
+	}
+	return nil
+}
+
+func (x *CarImage) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+func (x *CarImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
 	return ""
 }
 
-type CreateCarRequest struct {
+func (x *CarImage) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CarImage) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *CarImage) GetOrder() int64 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *CarImage) GetImgName() string {
+	if x != nil {
+		return x.ImgName
+	}
+	return ""
+}
+
+func (x *CarImage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCarImagesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	CarID *UUID `protobuf:"bytes,1,opt,name=carID,proto3" json:"carID,omitempty"`
 }
 
-func (x *CreateCarRequest) Reset() {
-	*x = CreateCarRequest{}
+func (x *ListCarImagesRequest) Reset() {
+	*x = ListCarImagesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -413,13 +818,13 @@ func (x *CreateCarRequest) Reset() {
 	}
 }
 
-func (x *CreateCarRequest) String() string {
+func (x *ListCarImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCarRequest) ProtoMessage() {}
+func (*ListCarImagesRequest) ProtoMessage() {}
 
-func (x *CreateCarRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCarImagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,28 +836,28 @@ func (x *CreateCarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCarRequest.ProtoReflect.Descriptor instead.
-func (*CreateCarRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCarImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListCarImagesRequest) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateCarRequest) GetCar() *Car {
+func (x *ListCarImagesRequest) GetCarID() *UUID {
 	if x != nil {
-		return x.Car
+		return x.CarID
 	}
 	return nil
 }
 
-type CreateCarResponse struct {
+type ListCarImagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	Images []*CarImage `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
 }
 
-func (x *CreateCarResponse) Reset() {
-	*x = CreateCarResponse{}
+func (x *ListCarImagesResponse) Reset() {
+	*x = ListCarImagesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,13 +865,13 @@ func (x *CreateCarResponse) Reset() {
 	}
 }
 
-func (x *CreateCarResponse) String() string {
+func (x *ListCarImagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCarResponse) ProtoMessage() {}
+func (*ListCarImagesResponse) ProtoMessage() {}
 
-func (x *CreateCarResponse) ProtoReflect() protoreflect.Message {
+func (x *ListCarImagesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -478,28 +883,29 @@ func (x *CreateCarResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCarResponse.ProtoReflect.Descriptor instead.
-func (*CreateCarResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCarImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListCarImagesResponse) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateCarResponse) GetCar() *Car {
+func (x *ListCarImagesResponse) GetImages() []*CarImage {
 	if x != nil {
-		return x.Car
+		return x.Images
 	}
 	return nil
 }
 
-type GetCarRequest struct {
+type SetPrimaryCarImageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CarID   *UUID `protobuf:"bytes,1,opt,name=carID,proto3" json:"carID,omitempty"`
+	ImageID *UUID `protobuf:"bytes,2,opt,name=imageID,proto3" json:"imageID,omitempty"`
 }
 
-func (x *GetCarRequest) Reset() {
-	*x = GetCarRequest{}
+func (x *SetPrimaryCarImageRequest) Reset() {
+	*x = SetPrimaryCarImageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,13 +913,13 @@ func (x *GetCarRequest) Reset() {
 	}
 }
 
-func (x *GetCarRequest) String() string {
+func (x *SetPrimaryCarImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCarRequest) ProtoMessage() {}
+func (*SetPrimaryCarImageRequest) ProtoMessage() {}
 
-func (x *GetCarRequest) ProtoReflect() protoreflect.Message {
+func (x *SetPrimaryCarImageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,28 +931,35 @@ func (x *GetCarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCarRequest.ProtoReflect.Descriptor instead.
-func (*GetCarRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetPrimaryCarImageRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryCarImageRequest) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetCarRequest) GetID() *UUID {
+func (x *SetPrimaryCarImageRequest) GetCarID() *UUID {
 	if x != nil {
-		return x.ID
+		return x.CarID
 	}
 	return nil
 }
 
-type GetCarResponse struct {
+func (x *SetPrimaryCarImageRequest) GetImageID() *UUID {
+	if x != nil {
+		return x.ImageID
+	}
+	return nil
+}
+
+type SetPrimaryCarImageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	Images []*CarImage `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
 }
 
-func (x *GetCarResponse) Reset() {
-	*x = GetCarResponse{}
+func (x *SetPrimaryCarImageResponse) Reset() {
+	*x = SetPrimaryCarImageResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,13 +967,13 @@ func (x *GetCarResponse) Reset() {
 	}
 }
 
-func (x *GetCarResponse) String() string {
+func (x *SetPrimaryCarImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCarResponse) ProtoMessage() {}
+func (*SetPrimaryCarImageResponse) ProtoMessage() {}
 
-func (x *GetCarResponse) ProtoReflect() protoreflect.Message {
+func (x *SetPrimaryCarImageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,28 +985,28 @@ func (x *GetCarResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCarResponse.ProtoReflect.Descriptor instead.
-func (*GetCarResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetPrimaryCarImageResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryCarImageResponse) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetCarResponse) GetCar() *Car {
+func (x *SetPrimaryCarImageResponse) GetImages() []*CarImage {
 	if x != nil {
-		return x.Car
+		return x.Images
 	}
 	return nil
 }
 
-type DeleteCarRequest struct {
+type UUID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *DeleteCarRequest) Reset() {
-	*x = DeleteCarRequest{}
+func (x *UUID) Reset() {
+	*x = UUID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -601,13 +1014,13 @@ func (x *DeleteCarRequest) Reset() {
 	}
 }
 
-func (x *DeleteCarRequest) String() string {
+func (x *UUID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCarRequest) ProtoMessage() {}
+func (*UUID) ProtoMessage() {}
 
-func (x *DeleteCarRequest) ProtoReflect() protoreflect.Message {
+func (x *UUID) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -619,28 +1032,28 @@ func (x *DeleteCarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCarRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCarRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UUID.ProtoReflect.Descriptor instead.
+func (*UUID) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteCarRequest) GetID() *UUID {
+func (x *UUID) GetValue() string {
 	if x != nil {
-		return x.ID
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type DeleteCarResponse struct {
+type CreateCarRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
 }
 
-func (x *DeleteCarResponse) Reset() {
-	*x = DeleteCarResponse{}
+func (x *CreateCarRequest) Reset() {
+	*x = CreateCarRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -648,13 +1061,13 @@ func (x *DeleteCarResponse) Reset() {
 	}
 }
 
-func (x *DeleteCarResponse) String() string {
+func (x *CreateCarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCarResponse) ProtoMessage() {}
+func (*CreateCarRequest) ProtoMessage() {}
 
-func (x *DeleteCarResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateCarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,19 +1079,19 @@ func (x *DeleteCarResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCarResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCarResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCarRequest.ProtoReflect.Descriptor instead.
+func (*CreateCarRequest) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DeleteCarResponse) GetID() *UUID {
+func (x *CreateCarRequest) GetCar() *Car {
 	if x != nil {
-		return x.ID
+		return x.Car
 	}
 	return nil
 }
 
-type UpdateCarRequest struct {
+type CreateCarResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -686,8 +1099,8 @@ type UpdateCarRequest struct {
 	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
 }
 
-func (x *UpdateCarRequest) Reset() {
-	*x = UpdateCarRequest{}
+func (x *CreateCarResponse) Reset() {
+	*x = CreateCarResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -695,13 +1108,13 @@ func (x *UpdateCarRequest) Reset() {
 	}
 }
 
-func (x *UpdateCarRequest) String() string {
+func (x *CreateCarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCarRequest) ProtoMessage() {}
+func (*CreateCarResponse) ProtoMessage() {}
 
-func (x *UpdateCarRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateCarResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -713,28 +1126,28 @@ func (x *UpdateCarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCarRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCarRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCarResponse.ProtoReflect.Descriptor instead.
+func (*CreateCarResponse) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateCarRequest) GetCar() *Car {
+func (x *CreateCarResponse) GetCar() *Car {
 	if x != nil {
 		return x.Car
 	}
 	return nil
 }
 
-type UpdateCarResponse struct {
+type GetCarRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 }
 
-func (x *UpdateCarResponse) Reset() {
-	*x = UpdateCarResponse{}
+func (x *GetCarRequest) Reset() {
+	*x = GetCarRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -742,13 +1155,13 @@ func (x *UpdateCarResponse) Reset() {
 	}
 }
 
-func (x *UpdateCarResponse) String() string {
+func (x *GetCarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCarResponse) ProtoMessage() {}
+func (*GetCarRequest) ProtoMessage() {}
 
-func (x *UpdateCarResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -760,26 +1173,28 @@ func (x *UpdateCarResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCarResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCarResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCarRequest.ProtoReflect.Descriptor instead.
+func (*GetCarRequest) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateCarResponse) GetCar() *Car {
+func (x *GetCarRequest) GetID() *UUID {
 	if x != nil {
-		return x.Car
+		return x.ID
 	}
 	return nil
 }
 
-type GetAllCarsRequest struct {
+type GetCarResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
 }
 
-func (x *GetAllCarsRequest) Reset() {
-	*x = GetAllCarsRequest{}
+func (x *GetCarResponse) Reset() {
+	*x = GetCarResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -787,13 +1202,13 @@ func (x *GetAllCarsRequest) Reset() {
 	}
 }
 
-func (x *GetAllCarsRequest) String() string {
+func (x *GetCarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllCarsRequest) ProtoMessage() {}
+func (*GetCarResponse) ProtoMessage() {}
 
-func (x *GetAllCarsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCarResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,21 +1220,28 @@ func (x *GetAllCarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllCarsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllCarsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCarResponse.ProtoReflect.Descriptor instead.
+func (*GetCarResponse) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{15}
 }
 
-type GetAllCarsResponse struct {
+func (x *GetCarResponse) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+type DeleteCarRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cars []*Car `protobuf:"bytes,1,rep,name=cars,proto3" json:"cars,omitempty"`
+	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 }
 
-func (x *GetAllCarsResponse) Reset() {
-	*x = GetAllCarsResponse{}
+func (x *DeleteCarRequest) Reset() {
+	*x = DeleteCarRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -827,13 +1249,13 @@ func (x *GetAllCarsResponse) Reset() {
 	}
 }
 
-func (x *GetAllCarsResponse) String() string {
+func (x *DeleteCarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllCarsResponse) ProtoMessage() {}
+func (*DeleteCarRequest) ProtoMessage() {}
 
-func (x *GetAllCarsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteCarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -845,29 +1267,28 @@ func (x *GetAllCarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllCarsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllCarsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCarRequest) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetAllCarsResponse) GetCars() []*Car {
+func (x *DeleteCarRequest) GetID() *UUID {
 	if x != nil {
-		return x.Cars
+		return x.ID
 	}
 	return nil
 }
 
-type SignUpUserRequest struct {
+type DeleteCarResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 }
 
-func (x *SignUpUserRequest) Reset() {
-	*x = SignUpUserRequest{}
+func (x *DeleteCarResponse) Reset() {
+	*x = DeleteCarResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,13 +1296,13 @@ func (x *SignUpUserRequest) Reset() {
 	}
 }
 
-func (x *SignUpUserRequest) String() string {
+func (x *DeleteCarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignUpUserRequest) ProtoMessage() {}
+func (*DeleteCarResponse) ProtoMessage() {}
 
-func (x *SignUpUserRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteCarResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,36 +1314,29 @@ func (x *SignUpUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignUpUserRequest.ProtoReflect.Descriptor instead.
-func (*SignUpUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCarResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCarResponse) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SignUpUserRequest) GetLogin() string {
-	if x != nil {
-		return x.Login
-	}
-	return ""
-}
-
-func (x *SignUpUserRequest) GetPassword() string {
+func (x *DeleteCarResponse) GetID() *UUID {
 	if x != nil {
-		return x.Password
+		return x.ID
 	}
-	return ""
+	return nil
 }
 
-type SignUpUserResponse struct {
+type UpdateCarRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Car        *Car                   `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
 }
 
-func (x *SignUpUserResponse) Reset() {
-	*x = SignUpUserResponse{}
+func (x *UpdateCarRequest) Reset() {
+	*x = UpdateCarRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,13 +1344,13 @@ func (x *SignUpUserResponse) Reset() {
 	}
 }
 
-func (x *SignUpUserResponse) String() string {
+func (x *UpdateCarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignUpUserResponse) ProtoMessage() {}
+func (*UpdateCarRequest) ProtoMessage() {}
 
-func (x *SignUpUserResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateCarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -948,36 +1362,35 @@ func (x *SignUpUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignUpUserResponse.ProtoReflect.Descriptor instead.
-func (*SignUpUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCarRequest) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SignUpUserResponse) GetAccessToken() string {
+func (x *UpdateCarRequest) GetCar() *Car {
 	if x != nil {
-		return x.AccessToken
+		return x.Car
 	}
-	return ""
+	return nil
 }
 
-func (x *SignUpUserResponse) GetRefreshToken() string {
+func (x *UpdateCarRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.RefreshToken
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-type SignUpAdminRequest struct {
+type UpdateCarResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
 }
 
-func (x *SignUpAdminRequest) Reset() {
-	*x = SignUpAdminRequest{}
+func (x *UpdateCarResponse) Reset() {
+	*x = UpdateCarResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -985,13 +1398,13 @@ func (x *SignUpAdminRequest) Reset() {
 	}
 }
 
-func (x *SignUpAdminRequest) String() string {
+func (x *UpdateCarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignUpAdminRequest) ProtoMessage() {}
+func (*UpdateCarResponse) ProtoMessage() {}
 
-func (x *SignUpAdminRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateCarResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1003,36 +1416,36 @@ func (x *SignUpAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignUpAdminRequest.ProtoReflect.Descriptor instead.
-func (*SignUpAdminRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCarResponse) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SignUpAdminRequest) GetLogin() string {
-	if x != nil {
-		return x.Login
-	}
-	return ""
-}
-
-func (x *SignUpAdminRequest) GetPassword() string {
+func (x *UpdateCarResponse) GetCar() *Car {
 	if x != nil {
-		return x.Password
+		return x.Car
 	}
-	return ""
+	return nil
 }
 
-type SignUpAdminResponse struct {
+type GetAllCarsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Brand             string                `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	MinProductionYear int64                 `protobuf:"varint,2,opt,name=minProductionYear,proto3" json:"minProductionYear,omitempty"`
+	MaxProductionYear int64                 `protobuf:"varint,3,opt,name=maxProductionYear,proto3" json:"maxProductionYear,omitempty"`
+	IsRunning         *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=isRunning,proto3" json:"isRunning,omitempty"`
+	SortBy            CarSortField          `protobuf:"varint,5,opt,name=sortBy,proto3,enum=CarSortField" json:"sortBy,omitempty"`
+	Descending        bool                  `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize          int32                 `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken         string                `protobuf:"bytes,8,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	LabelSelector     string                `protobuf:"bytes,9,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 }
 
-func (x *SignUpAdminResponse) Reset() {
-	*x = SignUpAdminResponse{}
+func (x *GetAllCarsRequest) Reset() {
+	*x = GetAllCarsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1040,13 +1453,13 @@ func (x *SignUpAdminResponse) Reset() {
 	}
 }
 
-func (x *SignUpAdminResponse) String() string {
+func (x *GetAllCarsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignUpAdminResponse) ProtoMessage() {}
+func (*GetAllCarsRequest) ProtoMessage() {}
 
-func (x *SignUpAdminResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAllCarsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1058,36 +1471,85 @@ func (x *SignUpAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignUpAdminResponse.ProtoReflect.Descriptor instead.
-func (*SignUpAdminResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllCarsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCarsRequest) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SignUpAdminResponse) GetAccessToken() string {
+func (x *GetAllCarsRequest) GetBrand() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Brand
 	}
 	return ""
 }
 
-func (x *SignUpAdminResponse) GetRefreshToken() string {
+func (x *GetAllCarsRequest) GetMinProductionYear() int64 {
 	if x != nil {
-		return x.RefreshToken
+		return x.MinProductionYear
+	}
+	return 0
+}
+
+func (x *GetAllCarsRequest) GetMaxProductionYear() int64 {
+	if x != nil {
+		return x.MaxProductionYear
+	}
+	return 0
+}
+
+func (x *GetAllCarsRequest) GetIsRunning() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IsRunning
+	}
+	return nil
+}
+
+func (x *GetAllCarsRequest) GetSortBy() CarSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return CarSortField_SORT_BY_ID
+}
+
+func (x *GetAllCarsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *GetAllCarsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllCarsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type GetByLoginRequest struct {
+func (x *GetAllCarsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type GetAllCarsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Cars          []*Car `protobuf:"bytes,1,rep,name=cars,proto3" json:"cars,omitempty"`
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *GetByLoginRequest) Reset() {
-	*x = GetByLoginRequest{}
+func (x *GetAllCarsResponse) Reset() {
+	*x = GetAllCarsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,13 +1557,13 @@ func (x *GetByLoginRequest) Reset() {
 	}
 }
 
-func (x *GetByLoginRequest) String() string {
+func (x *GetAllCarsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByLoginRequest) ProtoMessage() {}
+func (*GetAllCarsResponse) ProtoMessage() {}
 
-func (x *GetByLoginRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAllCarsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1113,36 +1575,41 @@ func (x *GetByLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByLoginRequest.ProtoReflect.Descriptor instead.
-func (*GetByLoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllCarsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllCarsResponse) Descriptor() ([]byte, []int) {
 	return file_services_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetByLoginRequest) GetLogin() string {
+func (x *GetAllCarsResponse) GetCars() []*Car {
 	if x != nil {
-		return x.Login
+		return x.Cars
 	}
-	return ""
+	return nil
 }
 
-func (x *GetByLoginRequest) GetPassword() string {
+func (x *GetAllCarsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Password
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type GetByLoginResponse struct {
+type ListCarsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	Brand             string                `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	MinProductionYear int64                 `protobuf:"varint,2,opt,name=minProductionYear,proto3" json:"minProductionYear,omitempty"`
+	MaxProductionYear int64                 `protobuf:"varint,3,opt,name=maxProductionYear,proto3" json:"maxProductionYear,omitempty"`
+	IsRunning         *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=isRunning,proto3" json:"isRunning,omitempty"`
+	SortBy            CarSortField          `protobuf:"varint,5,opt,name=sortBy,proto3,enum=CarSortField" json:"sortBy,omitempty"`
+	Descending        bool                  `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	LabelSelector     string                `protobuf:"bytes,7,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
 }
 
-func (x *GetByLoginResponse) Reset() {
-	*x = GetByLoginResponse{}
+func (x *ListCarsRequest) Reset() {
+	*x = ListCarsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1150,391 +1617,5187 @@ func (x *GetByLoginResponse) Reset() {
 	}
 }
 
-func (x *GetByLoginResponse) String() string {
+func (x *ListCarsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByLoginResponse) ProtoMessage() {}
+func (*ListCarsRequest) ProtoMessage() {}
 
-func (x *GetByLoginResponse) ProtoReflect() protoreflect.Message {
+func (x *ListCarsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetByLoginResponse.ProtoReflect.Descriptor instead.
-func (*GetByLoginResponse) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *GetByLoginResponse) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *GetByLoginResponse) GetRefreshToken() string {
-	if x != nil {
-		return x.RefreshToken
-	}
-	return ""
-}
-
-type RefreshTokenRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
-}
-
-func (x *RefreshTokenRequest) Reset() {
-	*x = RefreshTokenRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RefreshTokenRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RefreshTokenRequest) ProtoMessage() {}
-
-func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCarsRequest.ProtoReflect.Descriptor instead.
+func (*ListCarsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCarsRequest) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *ListCarsRequest) GetMinProductionYear() int64 {
+	if x != nil {
+		return x.MinProductionYear
+	}
+	return 0
+}
+
+func (x *ListCarsRequest) GetMaxProductionYear() int64 {
+	if x != nil {
+		return x.MaxProductionYear
+	}
+	return 0
+}
+
+func (x *ListCarsRequest) GetIsRunning() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IsRunning
+	}
+	return nil
+}
+
+func (x *ListCarsRequest) GetSortBy() CarSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return CarSortField_SORT_BY_ID
+}
+
+func (x *ListCarsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListCarsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type RestoreCarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *RestoreCarRequest) Reset() {
+	*x = RestoreCarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCarRequest) ProtoMessage() {}
+
+func (x *RestoreCarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCarRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCarRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreCarRequest) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+type RestoreCarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+}
+
+func (x *RestoreCarResponse) Reset() {
+	*x = RestoreCarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCarResponse) ProtoMessage() {}
+
+func (x *RestoreCarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCarResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCarResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreCarResponse) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+type PurgeDeletedCarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PurgeDeletedCarsRequest) Reset() {
+	*x = PurgeDeletedCarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeDeletedCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedCarsRequest) ProtoMessage() {}
+
+func (x *PurgeDeletedCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedCarsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedCarsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{25}
+}
+
+type PurgeDeletedCarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purged int64 `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+}
+
+func (x *PurgeDeletedCarsResponse) Reset() {
+	*x = PurgeDeletedCarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeDeletedCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedCarsResponse) ProtoMessage() {}
+
+func (x *PurgeDeletedCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedCarsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedCarsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PurgeDeletedCarsResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+type CarChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        *UUID                  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CarID     *UUID                  `protobuf:"bytes,2,opt,name=carID,proto3" json:"carID,omitempty"`
+	ActorID   *UUID                  `protobuf:"bytes,3,opt,name=actorID,proto3" json:"actorID,omitempty"`
+	Action    CarChangeAction        `protobuf:"varint,4,opt,name=action,proto3,enum=CarChangeAction" json:"action,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changedAt,proto3" json:"changedAt,omitempty"`
+	Before    *Car                   `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After     *Car                   `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *CarChange) Reset() {
+	*x = CarChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarChange) ProtoMessage() {}
+
+func (x *CarChange) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarChange.ProtoReflect.Descriptor instead.
+func (*CarChange) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CarChange) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+func (x *CarChange) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+func (x *CarChange) GetActorID() *UUID {
+	if x != nil {
+		return x.ActorID
+	}
+	return nil
+}
+
+func (x *CarChange) GetAction() CarChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return CarChangeAction_CAR_CHANGE_ACTION_UNSPECIFIED
+}
+
+func (x *CarChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *CarChange) GetBefore() *Car {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CarChange) GetAfter() *Car {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type GetCarHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarID     *UUID  `protobuf:"bytes,1,opt,name=carID,proto3" json:"carID,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *GetCarHistoryRequest) Reset() {
+	*x = GetCarHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarHistoryRequest) ProtoMessage() {}
+
+func (x *GetCarHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCarHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCarHistoryRequest) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+func (x *GetCarHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCarHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetCarHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes       []*CarChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *GetCarHistoryResponse) Reset() {
+	*x = GetCarHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarHistoryResponse) ProtoMessage() {}
+
+func (x *GetCarHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCarHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCarHistoryResponse) GetChanges() []*CarChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GetCarHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchCreateCarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cars []*Car `protobuf:"bytes,1,rep,name=cars,proto3" json:"cars,omitempty"`
+}
+
+func (x *BatchCreateCarsRequest) Reset() {
+	*x = BatchCreateCarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateCarsRequest) ProtoMessage() {}
+
+func (x *BatchCreateCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateCarsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateCarsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchCreateCarsRequest) GetCars() []*Car {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+type BatchCreateCarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cars []*Car `protobuf:"bytes,1,rep,name=cars,proto3" json:"cars,omitempty"`
+}
+
+func (x *BatchCreateCarsResponse) Reset() {
+	*x = BatchCreateCarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateCarsResponse) ProtoMessage() {}
+
+func (x *BatchCreateCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateCarsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateCarsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchCreateCarsResponse) GetCars() []*Car {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+type BatchUpdateCarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*UpdateCarRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchUpdateCarsRequest) Reset() {
+	*x = BatchUpdateCarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCarsRequest) ProtoMessage() {}
+
+func (x *BatchUpdateCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCarsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCarsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BatchUpdateCarsRequest) GetRequests() []*UpdateCarRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchUpdateCarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cars []*Car `protobuf:"bytes,1,rep,name=cars,proto3" json:"cars,omitempty"`
+}
+
+func (x *BatchUpdateCarsResponse) Reset() {
+	*x = BatchUpdateCarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCarsResponse) ProtoMessage() {}
+
+func (x *BatchUpdateCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCarsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCarsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchUpdateCarsResponse) GetCars() []*Car {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+type BatchDeleteCarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDs []*UUID `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
+}
+
+func (x *BatchDeleteCarsRequest) Reset() {
+	*x = BatchDeleteCarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteCarsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteCarsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteCarsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BatchDeleteCarsRequest) GetIDs() []*UUID {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+type BatchDeleteCarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDs []*UUID `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
+}
+
+func (x *BatchDeleteCarsResponse) Reset() {
+	*x = BatchDeleteCarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteCarsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteCarsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteCarsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BatchDeleteCarsResponse) GetIDs() []*UUID {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+type ImportCarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format CarDataFormat `protobuf:"varint,1,opt,name=format,proto3,enum=CarDataFormat" json:"format,omitempty"`
+	DryRun bool          `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Chunk  []byte        `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ImportCarsRequest) Reset() {
+	*x = ImportCarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCarsRequest) ProtoMessage() {}
+
+func (x *ImportCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCarsRequest.ProtoReflect.Descriptor instead.
+func (*ImportCarsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ImportCarsRequest) GetFormat() CarDataFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CarDataFormat_CAR_DATA_FORMAT_CSV
+}
+
+func (x *ImportCarsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportCarsRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportCarsRowIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line   int64           `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Status CarImportStatus `protobuf:"varint,2,opt,name=status,proto3,enum=CarImportStatus" json:"status,omitempty"`
+	Reason string          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ImportCarsRowIssue) Reset() {
+	*x = ImportCarsRowIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCarsRowIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCarsRowIssue) ProtoMessage() {}
+
+func (x *ImportCarsRowIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCarsRowIssue.ProtoReflect.Descriptor instead.
+func (*ImportCarsRowIssue) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ImportCarsRowIssue) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportCarsRowIssue) GetStatus() CarImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CarImportStatus_CAR_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ImportCarsRowIssue) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportCarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported int64                 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Skipped  int64                 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed   int64                 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Issues   []*ImportCarsRowIssue `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	DryRun   bool                  `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *ImportCarsResponse) Reset() {
+	*x = ImportCarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCarsResponse) ProtoMessage() {}
+
+func (x *ImportCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCarsResponse.ProtoReflect.Descriptor instead.
+func (*ImportCarsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportCarsResponse) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportCarsResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportCarsResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportCarsResponse) GetIssues() []*ImportCarsRowIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ImportCarsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ExportCarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand             string                `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	MinProductionYear int64                 `protobuf:"varint,2,opt,name=minProductionYear,proto3" json:"minProductionYear,omitempty"`
+	MaxProductionYear int64                 `protobuf:"varint,3,opt,name=maxProductionYear,proto3" json:"maxProductionYear,omitempty"`
+	IsRunning         *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=isRunning,proto3" json:"isRunning,omitempty"`
+	SortBy            CarSortField          `protobuf:"varint,5,opt,name=sortBy,proto3,enum=CarSortField" json:"sortBy,omitempty"`
+	Descending        bool                  `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	Format            CarDataFormat         `protobuf:"varint,7,opt,name=format,proto3,enum=CarDataFormat" json:"format,omitempty"`
+	LabelSelector     string                `protobuf:"bytes,8,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+}
+
+func (x *ExportCarsRequest) Reset() {
+	*x = ExportCarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCarsRequest) ProtoMessage() {}
+
+func (x *ExportCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCarsRequest.ProtoReflect.Descriptor instead.
+func (*ExportCarsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExportCarsRequest) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *ExportCarsRequest) GetMinProductionYear() int64 {
+	if x != nil {
+		return x.MinProductionYear
+	}
+	return 0
+}
+
+func (x *ExportCarsRequest) GetMaxProductionYear() int64 {
+	if x != nil {
+		return x.MaxProductionYear
+	}
+	return 0
+}
+
+func (x *ExportCarsRequest) GetIsRunning() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IsRunning
+	}
+	return nil
+}
+
+func (x *ExportCarsRequest) GetSortBy() CarSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return CarSortField_SORT_BY_ID
+}
+
+func (x *ExportCarsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ExportCarsRequest) GetFormat() CarDataFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CarDataFormat_CAR_DATA_FORMAT_CSV
+}
+
+func (x *ExportCarsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type ExportCarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ExportCarsResponse) Reset() {
+	*x = ExportCarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCarsResponse) ProtoMessage() {}
+
+func (x *ExportCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCarsResponse.ProtoReflect.Descriptor instead.
+func (*ExportCarsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExportCarsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type GetCarByVINRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VIN string `protobuf:"bytes,1,opt,name=VIN,proto3" json:"VIN,omitempty"`
+}
+
+func (x *GetCarByVINRequest) Reset() {
+	*x = GetCarByVINRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarByVINRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarByVINRequest) ProtoMessage() {}
+
+func (x *GetCarByVINRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarByVINRequest.ProtoReflect.Descriptor instead.
+func (*GetCarByVINRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCarByVINRequest) GetVIN() string {
+	if x != nil {
+		return x.VIN
+	}
+	return ""
+}
+
+type GetCarByVINResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Car *Car `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+}
+
+func (x *GetCarByVINResponse) Reset() {
+	*x = GetCarByVINResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarByVINResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarByVINResponse) ProtoMessage() {}
+
+func (x *GetCarByVINResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarByVINResponse.ProtoReflect.Descriptor instead.
+func (*GetCarByVINResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetCarByVINResponse) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+type WatchCarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand string `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	CarID *UUID  `protobuf:"bytes,2,opt,name=carID,proto3" json:"carID,omitempty"`
+}
+
+func (x *WatchCarsRequest) Reset() {
+	*x = WatchCarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCarsRequest) ProtoMessage() {}
+
+func (x *WatchCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCarsRequest.ProtoReflect.Descriptor instead.
+func (*WatchCarsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WatchCarsRequest) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *WatchCarsRequest) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+type GetCarStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCarStatsRequest) Reset() {
+	*x = GetCarStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarStatsRequest) ProtoMessage() {}
+
+func (x *GetCarStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCarStatsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{44}
+}
+
+type BrandCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand string `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BrandCount) Reset() {
+	*x = BrandCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrandCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandCount) ProtoMessage() {}
+
+func (x *BrandCount) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandCount.ProtoReflect.Descriptor instead.
+func (*BrandCount) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BrandCount) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *BrandCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ProductionYearCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year  int64 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ProductionYearCount) Reset() {
+	*x = ProductionYearCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductionYearCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductionYearCount) ProtoMessage() {}
+
+func (x *ProductionYearCount) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductionYearCount.ProtoReflect.Descriptor instead.
+func (*ProductionYearCount) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProductionYearCount) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ProductionYearCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCarStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total           int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Running         int64                  `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	NotRunning      int64                  `protobuf:"varint,3,opt,name=notRunning,proto3" json:"notRunning,omitempty"`
+	RunningShare    float64                `protobuf:"fixed64,4,opt,name=runningShare,proto3" json:"runningShare,omitempty"`
+	NotRunningShare float64                `protobuf:"fixed64,5,opt,name=notRunningShare,proto3" json:"notRunningShare,omitempty"`
+	Brands          []*BrandCount          `protobuf:"bytes,6,rep,name=brands,proto3" json:"brands,omitempty"`
+	ProductionYears []*ProductionYearCount `protobuf:"bytes,7,rep,name=productionYears,proto3" json:"productionYears,omitempty"`
+}
+
+func (x *GetCarStatsResponse) Reset() {
+	*x = GetCarStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarStatsResponse) ProtoMessage() {}
+
+func (x *GetCarStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCarStatsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCarStatsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCarStatsResponse) GetRunning() int64 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *GetCarStatsResponse) GetNotRunning() int64 {
+	if x != nil {
+		return x.NotRunning
+	}
+	return 0
+}
+
+func (x *GetCarStatsResponse) GetRunningShare() float64 {
+	if x != nil {
+		return x.RunningShare
+	}
+	return 0
+}
+
+func (x *GetCarStatsResponse) GetNotRunningShare() float64 {
+	if x != nil {
+		return x.NotRunningShare
+	}
+	return 0
+}
+
+func (x *GetCarStatsResponse) GetBrands() []*BrandCount {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+func (x *GetCarStatsResponse) GetProductionYears() []*ProductionYearCount {
+	if x != nil {
+		return x.ProductionYears
+	}
+	return nil
+}
+
+type MaintenanceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          *UUID                  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CarID       *UUID                  `protobuf:"bytes,2,opt,name=carID,proto3" json:"carID,omitempty"`
+	Kind        MaintenanceKind        `protobuf:"varint,3,opt,name=kind,proto3,enum=MaintenanceKind" json:"kind,omitempty"`
+	PerformedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=performedAt,proto3" json:"performedAt,omitempty"`
+	Mileage     int64                  `protobuf:"varint,5,opt,name=mileage,proto3" json:"mileage,omitempty"`
+	Cost        int64                  `protobuf:"varint,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	Notes       string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	Critical    bool                   `protobuf:"varint,8,opt,name=critical,proto3" json:"critical,omitempty"`
+	ClosedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
+}
+
+func (x *MaintenanceRecord) Reset() {
+	*x = MaintenanceRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceRecord) ProtoMessage() {}
+
+func (x *MaintenanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceRecord.ProtoReflect.Descriptor instead.
+func (*MaintenanceRecord) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MaintenanceRecord) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+func (x *MaintenanceRecord) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+func (x *MaintenanceRecord) GetKind() MaintenanceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MaintenanceKind_MAINTENANCE_KIND_UNSPECIFIED
+}
+
+func (x *MaintenanceRecord) GetPerformedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PerformedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceRecord) GetMileage() int64 {
+	if x != nil {
+		return x.Mileage
+	}
+	return 0
+}
+
+func (x *MaintenanceRecord) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *MaintenanceRecord) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *MaintenanceRecord) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+func (x *MaintenanceRecord) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+type AddMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *MaintenanceRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Closed bool               `protobuf:"varint,2,opt,name=closed,proto3" json:"closed,omitempty"`
+}
+
+func (x *AddMaintenanceRequest) Reset() {
+	*x = AddMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMaintenanceRequest) ProtoMessage() {}
+
+func (x *AddMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*AddMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AddMaintenanceRequest) GetRecord() *MaintenanceRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *AddMaintenanceRequest) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+type AddMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *MaintenanceRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *AddMaintenanceResponse) Reset() {
+	*x = AddMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMaintenanceResponse) ProtoMessage() {}
+
+func (x *AddMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*AddMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AddMaintenanceResponse) GetRecord() *MaintenanceRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type CloseMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *CloseMaintenanceRequest) Reset() {
+	*x = CloseMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseMaintenanceRequest) ProtoMessage() {}
+
+func (x *CloseMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*CloseMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CloseMaintenanceRequest) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+type CloseMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *MaintenanceRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *CloseMaintenanceResponse) Reset() {
+	*x = CloseMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseMaintenanceResponse) ProtoMessage() {}
+
+func (x *CloseMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*CloseMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CloseMaintenanceResponse) GetRecord() *MaintenanceRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ListMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarID *UUID `protobuf:"bytes,1,opt,name=carID,proto3" json:"carID,omitempty"`
+}
+
+func (x *ListMaintenanceRequest) Reset() {
+	*x = ListMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListMaintenanceRequest) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+type ListMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*MaintenanceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *ListMaintenanceResponse) Reset() {
+	*x = ListMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListMaintenanceResponse) GetRecords() []*MaintenanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GetCarsDueForServiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCarsDueForServiceRequest) Reset() {
+	*x = GetCarsDueForServiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarsDueForServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarsDueForServiceRequest) ProtoMessage() {}
+
+func (x *GetCarsDueForServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarsDueForServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetCarsDueForServiceRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{55}
+}
+
+type ServiceDue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Car                *Car                   `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	LastServicedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=lastServicedAt,proto3" json:"lastServicedAt,omitempty"`
+	LastServiceMileage int64                  `protobuf:"varint,3,opt,name=lastServiceMileage,proto3" json:"lastServiceMileage,omitempty"`
+}
+
+func (x *ServiceDue) Reset() {
+	*x = ServiceDue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceDue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDue) ProtoMessage() {}
+
+func (x *ServiceDue) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDue.ProtoReflect.Descriptor instead.
+func (*ServiceDue) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ServiceDue) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+func (x *ServiceDue) GetLastServicedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastServicedAt
+	}
+	return nil
+}
+
+func (x *ServiceDue) GetLastServiceMileage() int64 {
+	if x != nil {
+		return x.LastServiceMileage
+	}
+	return 0
+}
+
+type GetCarsDueForServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cars []*ServiceDue `protobuf:"bytes,1,rep,name=cars,proto3" json:"cars,omitempty"`
+}
+
+func (x *GetCarsDueForServiceResponse) Reset() {
+	*x = GetCarsDueForServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCarsDueForServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCarsDueForServiceResponse) ProtoMessage() {}
+
+func (x *GetCarsDueForServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCarsDueForServiceResponse.ProtoReflect.Descriptor instead.
+func (*GetCarsDueForServiceResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetCarsDueForServiceResponse) GetCars() []*ServiceDue {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+type Reservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         *UUID                  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CarID      *UUID                  `protobuf:"bytes,2,opt,name=carID,proto3" json:"carID,omitempty"`
+	UserID     *UUID                  `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	StartsAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	CanceledAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=canceledAt,proto3" json:"canceledAt,omitempty"`
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Reservation) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+func (x *Reservation) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+func (x *Reservation) GetUserID() *UUID {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *Reservation) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetCanceledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
+type CreateReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarID    *UUID                  `protobuf:"bytes,1,opt,name=carID,proto3" json:"carID,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
+}
+
+func (x *CreateReservationRequest) Reset() {
+	*x = CreateReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationRequest) ProtoMessage() {}
+
+func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateReservationRequest) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateReservationRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type CreateReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation *Reservation `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+}
+
+func (x *CreateReservationResponse) Reset() {
+	*x = CreateReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationResponse) ProtoMessage() {}
+
+func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type CancelReservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *CancelReservationRequest) Reset() {
+	*x = CancelReservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationRequest) ProtoMessage() {}
+
+func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CancelReservationRequest) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+type CancelReservationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservation *Reservation `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+}
+
+func (x *CancelReservationResponse) Reset() {
+	*x = CancelReservationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationResponse) ProtoMessage() {}
+
+func (x *CancelReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationResponse.ProtoReflect.Descriptor instead.
+func (*CancelReservationResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CancelReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ListCarReservationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarID           *UUID `protobuf:"bytes,1,opt,name=carID,proto3" json:"carID,omitempty"`
+	IncludeCanceled bool  `protobuf:"varint,2,opt,name=includeCanceled,proto3" json:"includeCanceled,omitempty"`
+}
+
+func (x *ListCarReservationsRequest) Reset() {
+	*x = ListCarReservationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCarReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCarReservationsRequest) ProtoMessage() {}
+
+func (x *ListCarReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCarReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCarReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListCarReservationsRequest) GetCarID() *UUID {
+	if x != nil {
+		return x.CarID
+	}
+	return nil
+}
+
+func (x *ListCarReservationsRequest) GetIncludeCanceled() bool {
+	if x != nil {
+		return x.IncludeCanceled
+	}
+	return false
+}
+
+type ListUserReservationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          *UUID `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	IncludeCanceled bool  `protobuf:"varint,2,opt,name=includeCanceled,proto3" json:"includeCanceled,omitempty"`
+}
+
+func (x *ListUserReservationsRequest) Reset() {
+	*x = ListUserReservationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserReservationsRequest) ProtoMessage() {}
+
+func (x *ListUserReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListUserReservationsRequest) GetUserID() *UUID {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *ListUserReservationsRequest) GetIncludeCanceled() bool {
+	if x != nil {
+		return x.IncludeCanceled
+	}
+	return false
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reservations []*Reservation `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListReservationsResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+type Brand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      *UUID    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (x *Brand) Reset() {
+	*x = Brand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Brand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Brand) ProtoMessage() {}
+
+func (x *Brand) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Brand.ProtoReflect.Descriptor instead.
+func (*Brand) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Brand) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+func (x *Brand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Brand) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type CreateBrandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases []string `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (x *CreateBrandRequest) Reset() {
+	*x = CreateBrandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBrandRequest) ProtoMessage() {}
+
+func (x *CreateBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBrandRequest.ProtoReflect.Descriptor instead.
+func (*CreateBrandRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateBrandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBrandRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type CreateBrandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand *Brand `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+}
+
+func (x *CreateBrandResponse) Reset() {
+	*x = CreateBrandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBrandResponse) ProtoMessage() {}
+
+func (x *CreateBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBrandResponse.ProtoReflect.Descriptor instead.
+func (*CreateBrandResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateBrandResponse) GetBrand() *Brand {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+type GetBrandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *GetBrandRequest) Reset() {
+	*x = GetBrandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBrandRequest) ProtoMessage() {}
+
+func (x *GetBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBrandRequest.ProtoReflect.Descriptor instead.
+func (*GetBrandRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetBrandRequest) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+type GetBrandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand *Brand `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+}
+
+func (x *GetBrandResponse) Reset() {
+	*x = GetBrandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBrandResponse) ProtoMessage() {}
+
+func (x *GetBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBrandResponse.ProtoReflect.Descriptor instead.
+func (*GetBrandResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetBrandResponse) GetBrand() *Brand {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+type ListBrandsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBrandsRequest) Reset() {
+	*x = ListBrandsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBrandsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrandsRequest) ProtoMessage() {}
+
+func (x *ListBrandsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrandsRequest.ProtoReflect.Descriptor instead.
+func (*ListBrandsRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{71}
+}
+
+type ListBrandsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brands []*Brand `protobuf:"bytes,1,rep,name=brands,proto3" json:"brands,omitempty"`
+}
+
+func (x *ListBrandsResponse) Reset() {
+	*x = ListBrandsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBrandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrandsResponse) ProtoMessage() {}
+
+func (x *ListBrandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrandsResponse.ProtoReflect.Descriptor instead.
+func (*ListBrandsResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListBrandsResponse) GetBrands() []*Brand {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+type UpdateBrandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      *UUID    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (x *UpdateBrandRequest) Reset() {
+	*x = UpdateBrandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBrandRequest) ProtoMessage() {}
+
+func (x *UpdateBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBrandRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBrandRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateBrandRequest) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+func (x *UpdateBrandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBrandRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type UpdateBrandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand *Brand `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+}
+
+func (x *UpdateBrandResponse) Reset() {
+	*x = UpdateBrandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBrandResponse) ProtoMessage() {}
+
+func (x *UpdateBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBrandResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBrandResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateBrandResponse) GetBrand() *Brand {
+	if x != nil {
+		return x.Brand
+	}
+	return nil
+}
+
+type DeleteBrandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID *UUID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteBrandRequest) Reset() {
+	*x = DeleteBrandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBrandRequest) ProtoMessage() {}
+
+func (x *DeleteBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBrandRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBrandRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteBrandRequest) GetID() *UUID {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+type DeleteBrandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBrandResponse) Reset() {
+	*x = DeleteBrandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBrandResponse) ProtoMessage() {}
+
+func (x *DeleteBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBrandResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBrandResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{76}
+}
+
+type SignUpUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *SignUpUserRequest) Reset() {
+	*x = SignUpUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignUpUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpUserRequest) ProtoMessage() {}
+
+func (x *SignUpUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUpUserRequest.ProtoReflect.Descriptor instead.
+func (*SignUpUserRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SignUpUserRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SignUpUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SignUpUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+}
+
+func (x *SignUpUserResponse) Reset() {
+	*x = SignUpUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignUpUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpUserResponse) ProtoMessage() {}
+
+func (x *SignUpUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUpUserResponse.ProtoReflect.Descriptor instead.
+func (*SignUpUserResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SignUpUserResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SignUpUserResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type SignUpAdminRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *SignUpAdminRequest) Reset() {
+	*x = SignUpAdminRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignUpAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpAdminRequest) ProtoMessage() {}
+
+func (x *SignUpAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUpAdminRequest.ProtoReflect.Descriptor instead.
+func (*SignUpAdminRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SignUpAdminRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SignUpAdminRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SignUpAdminResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+}
+
+func (x *SignUpAdminResponse) Reset() {
+	*x = SignUpAdminResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignUpAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUpAdminResponse) ProtoMessage() {}
+
+func (x *SignUpAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUpAdminResponse.ProtoReflect.Descriptor instead.
+func (*SignUpAdminResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SignUpAdminResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SignUpAdminResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type GetByLoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *GetByLoginRequest) Reset() {
+	*x = GetByLoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByLoginRequest) ProtoMessage() {}
+
+func (x *GetByLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByLoginRequest.ProtoReflect.Descriptor instead.
+func (*GetByLoginRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetByLoginRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *GetByLoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type GetByLoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+}
+
+func (x *GetByLoginResponse) Reset() {
+	*x = GetByLoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByLoginResponse) ProtoMessage() {}
+
+func (x *GetByLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByLoginResponse.ProtoReflect.Descriptor instead.
+func (*GetByLoginResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetByLoginResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *GetByLoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RefreshTokenRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_services_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RefreshTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+var File_services_proto protoreflect.FileDescriptor
+
+var file_services_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x03, 0x0a, 0x03, 0x43, 0x61, 0x72, 0x12, 0x15, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f,
+	0x0a, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x07, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x49, 0x4e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x56, 0x49, 0x4e, 0x12, 0x14, 0x0a, 0x05, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x4d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x61, 0x72, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x1f, 0x0a, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x49, 0x44, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x01,
+	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x15, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x69, 0x6d, 0x67, 0x22, 0x43, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x6d, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x69, 0x6d, 0x67, 0x12, 0x1b,
+	0x0a, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x55, 0x55, 0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x22, 0x36, 0x0a, 0x13, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55,
+	0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x05, 0x63,
+	0x61, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x6d, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
+	0x6d, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x33, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x05,
+	0x63, 0x61, 0x72, 0x49, 0x44, 0x22, 0x3a, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x22, 0x59, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x43,
+	0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x55, 0x55, 0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x07, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55,
+	0x55, 0x49, 0x44, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x44, 0x22, 0x3f, 0x0a, 0x1a,
+	0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x61, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x1c, 0x0a,
+	0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43,
+	0x61, 0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x03,
+	0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52,
+	0x03, 0x63, 0x61, 0x72, 0x22, 0x26, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x22, 0x28, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61,
+	0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x29, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x2a, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x22, 0x66, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04,
+	0x2e, 0x43, 0x61, 0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x2b, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x63, 0x61,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x03, 0x63,
+	0x61, 0x72, 0x22, 0xe6, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x2c,
+	0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59,
+	0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x2c, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x69, 0x73,
+	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x69, 0x73, 0x52, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x43, 0x61, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x04, 0x63, 0x61, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xaa, 0x02, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d,
+	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x69, 0x73, 0x52, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x43, 0x61, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2a,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x12, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e,
+	0x43, 0x61, 0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x22, 0xfe, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x05,
+	0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55,
+	0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x07, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x43, 0x61, 0x72,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04,
+	0x2e, 0x43, 0x61, 0x72, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61,
+	0x72, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x63, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x32, 0x0a, 0x16,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x04, 0x63, 0x61, 0x72, 0x73,
+	0x22, 0x33, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x63,
+	0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52,
+	0x04, 0x63, 0x61, 0x72, 0x73, 0x22, 0x47, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x33,
+	0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x63, 0x61, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x04, 0x63,
+	0x61, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x32, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x69, 0x0a, 0x11, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x43, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x6a, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x61, 0x72, 0x73, 0x52, 0x6f, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x61, 0x72, 0x73, 0x52, 0x6f, 0x77, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xd4, 0x02, 0x0a, 0x11,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59,
+	0x65, 0x61, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x43, 0x61, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x43, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x2a, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x26,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x42, 0x79, 0x56, 0x49, 0x4e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x49, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x56, 0x49, 0x4e, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x42, 0x79, 0x56, 0x49, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72,
+	0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x45, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12,
+	0x1b, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x22, 0x14, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x38, 0x0a, 0x0a, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x13,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x98, 0x02,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x52, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x6f, 0x74,
+	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65,
+	0x61, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x11, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x15,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72,
+	0x49, 0x44, 0x12, 0x24, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x66,
+	0x6f, 0x72, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6c, 0x65, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5b,
+	0x0a, 0x15, 0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x16, 0x41,
+	0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x22, 0x30, 0x0a, 0x17, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52,
+	0x02, 0x49, 0x44, 0x22, 0x46, 0x0a, 0x18, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x35, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72,
+	0x49, 0x44, 0x22, 0x47, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x44, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0a, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x63, 0x61, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x03, 0x63, 0x61,
+	0x72, 0x12, 0x42, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69,
+	0x6c, 0x65, 0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x73,
+	0x44, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x75, 0x65,
+	0x52, 0x04, 0x63, 0x61, 0x72, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1b, 0x0a,
+	0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55,
+	0x55, 0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49,
+	0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x74, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65,
+	0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x3a, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x05,
+	0x63, 0x61, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41,
+	0x74, 0x22, 0x4b, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x31,
+	0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x4b, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05,
+	0x63, 0x61, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55,
+	0x49, 0x44, 0x52, 0x05, 0x63, 0x61, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x65, 0x64, 0x22, 0x66, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4c, 0x0a, 0x05, 0x42, 0x72, 0x61,
+	0x6e, 0x64, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64,
+	0x22, 0x28, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x22, 0x13, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x34, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x22, 0x33, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x72, 0x61,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x22, 0x2b, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x53,
+	0x69, 0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46,
+	0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5b, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x2a, 0x4e, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x49, 0x44, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x42, 0x52, 0x41,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f,
+	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10,
+	0x02, 0x2a, 0x79, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x41,
+	0x52, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x44, 0x0a, 0x0d,
+	0x43, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a,
+	0x13, 0x43, 0x41, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x41, 0x52, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e,
+	0x10, 0x01, 0x2a, 0x75, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x52, 0x5f, 0x49, 0x4d, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x41, 0x52, 0x5f,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41,
+	0x52, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x41, 0x52, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x97, 0x01, 0x0a, 0x0f, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a,
+	0x1c, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x41, 0x49, 0x4e,
+	0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f,
+	0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x49, 0x52, 0x45,
+	0x53, 0x10, 0x04, 0x32, 0xf8, 0x07, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12,
+	0x11, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x12, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x12, 0x11, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12, 0x11, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x73, 0x12,
+	0x12, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72,
+	0x12, 0x12, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x12,
+	0x18, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x39, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73, 0x12, 0x12,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73, 0x12, 0x12, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x42, 0x79, 0x56, 0x49, 0x4e, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x42, 0x79,
+	0x56, 0x49, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x42, 0x79, 0x56, 0x49, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x72, 0x73, 0x12,
+	0x11, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x43, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfa,
+	0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37,
+	0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x55,
+	0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x55, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x2e, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xc3, 0x02, 0x0a, 0x12,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x43, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x41,
+	0x64, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x73, 0x44, 0x75, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x44, 0x75, 0x65, 0x46,
+	0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x73, 0x44, 0x75, 0x65, 0x46, 0x6f, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x32, 0xd4, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xae, 0x02, 0x0a, 0x0c, 0x42, 0x72, 0x61,
+	0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x12, 0x13, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa3, 0x02, 0x0a, 0x0c, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x15, 0x2e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c,
+	0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x13, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x40, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x15, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x43, 0x61, 0x72, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x43, 0x61, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69,
+	0x73, 0x74, 0x75, 0x75, 0x72, 0x62, 0x69, 0x61, 0x2f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x41, 0x72, 0x74, 0x79, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_services_proto_rawDescOnce sync.Once
+	file_services_proto_rawDescData = file_services_proto_rawDesc
+)
+
+func file_services_proto_rawDescGZIP() []byte {
+	file_services_proto_rawDescOnce.Do(func() {
+		file_services_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_proto_rawDescData)
+	})
+	return file_services_proto_rawDescData
+}
+
+var file_services_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_services_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_services_proto_goTypes = []interface{}{
+	(CarSortField)(0),                    // 0: CarSortField
+	(CarChangeAction)(0),                 // 1: CarChangeAction
+	(CarDataFormat)(0),                   // 2: CarDataFormat
+	(CarImportStatus)(0),                 // 3: CarImportStatus
+	(MaintenanceKind)(0),                 // 4: MaintenanceKind
+	(*Car)(nil),                          // 5: Car
+	(*User)(nil),                         // 6: User
+	(*DownloadImageRequest)(nil),         // 7: DownloadImageRequest
+	(*DownloadImageResponse)(nil),        // 8: DownloadImageResponse
+	(*UploadImageRequest)(nil),           // 9: UploadImageRequest
+	(*UploadImageResponse)(nil),          // 10: UploadImageResponse
+	(*CarImage)(nil),                     // 11: CarImage
+	(*ListCarImagesRequest)(nil),         // 12: ListCarImagesRequest
+	(*ListCarImagesResponse)(nil),        // 13: ListCarImagesResponse
+	(*SetPrimaryCarImageRequest)(nil),    // 14: SetPrimaryCarImageRequest
+	(*SetPrimaryCarImageResponse)(nil),   // 15: SetPrimaryCarImageResponse
+	(*UUID)(nil),                         // 16: UUID
+	(*CreateCarRequest)(nil),             // 17: CreateCarRequest
+	(*CreateCarResponse)(nil),            // 18: CreateCarResponse
+	(*GetCarRequest)(nil),                // 19: GetCarRequest
+	(*GetCarResponse)(nil),               // 20: GetCarResponse
+	(*DeleteCarRequest)(nil),             // 21: DeleteCarRequest
+	(*DeleteCarResponse)(nil),            // 22: DeleteCarResponse
+	(*UpdateCarRequest)(nil),             // 23: UpdateCarRequest
+	(*UpdateCarResponse)(nil),            // 24: UpdateCarResponse
+	(*GetAllCarsRequest)(nil),            // 25: GetAllCarsRequest
+	(*GetAllCarsResponse)(nil),           // 26: GetAllCarsResponse
+	(*ListCarsRequest)(nil),              // 27: ListCarsRequest
+	(*RestoreCarRequest)(nil),            // 28: RestoreCarRequest
+	(*RestoreCarResponse)(nil),           // 29: RestoreCarResponse
+	(*PurgeDeletedCarsRequest)(nil),      // 30: PurgeDeletedCarsRequest
+	(*PurgeDeletedCarsResponse)(nil),     // 31: PurgeDeletedCarsResponse
+	(*CarChange)(nil),                    // 32: CarChange
+	(*GetCarHistoryRequest)(nil),         // 33: GetCarHistoryRequest
+	(*GetCarHistoryResponse)(nil),        // 34: GetCarHistoryResponse
+	(*BatchCreateCarsRequest)(nil),       // 35: BatchCreateCarsRequest
+	(*BatchCreateCarsResponse)(nil),      // 36: BatchCreateCarsResponse
+	(*BatchUpdateCarsRequest)(nil),       // 37: BatchUpdateCarsRequest
+	(*BatchUpdateCarsResponse)(nil),      // 38: BatchUpdateCarsResponse
+	(*BatchDeleteCarsRequest)(nil),       // 39: BatchDeleteCarsRequest
+	(*BatchDeleteCarsResponse)(nil),      // 40: BatchDeleteCarsResponse
+	(*ImportCarsRequest)(nil),            // 41: ImportCarsRequest
+	(*ImportCarsRowIssue)(nil),           // 42: ImportCarsRowIssue
+	(*ImportCarsResponse)(nil),           // 43: ImportCarsResponse
+	(*ExportCarsRequest)(nil),            // 44: ExportCarsRequest
+	(*ExportCarsResponse)(nil),           // 45: ExportCarsResponse
+	(*GetCarByVINRequest)(nil),           // 46: GetCarByVINRequest
+	(*GetCarByVINResponse)(nil),          // 47: GetCarByVINResponse
+	(*WatchCarsRequest)(nil),             // 48: WatchCarsRequest
+	(*GetCarStatsRequest)(nil),           // 49: GetCarStatsRequest
+	(*BrandCount)(nil),                   // 50: BrandCount
+	(*ProductionYearCount)(nil),          // 51: ProductionYearCount
+	(*GetCarStatsResponse)(nil),          // 52: GetCarStatsResponse
+	(*MaintenanceRecord)(nil),            // 53: MaintenanceRecord
+	(*AddMaintenanceRequest)(nil),        // 54: AddMaintenanceRequest
+	(*AddMaintenanceResponse)(nil),       // 55: AddMaintenanceResponse
+	(*CloseMaintenanceRequest)(nil),      // 56: CloseMaintenanceRequest
+	(*CloseMaintenanceResponse)(nil),     // 57: CloseMaintenanceResponse
+	(*ListMaintenanceRequest)(nil),       // 58: ListMaintenanceRequest
+	(*ListMaintenanceResponse)(nil),      // 59: ListMaintenanceResponse
+	(*GetCarsDueForServiceRequest)(nil),  // 60: GetCarsDueForServiceRequest
+	(*ServiceDue)(nil),                   // 61: ServiceDue
+	(*GetCarsDueForServiceResponse)(nil), // 62: GetCarsDueForServiceResponse
+	(*Reservation)(nil),                  // 63: Reservation
+	(*CreateReservationRequest)(nil),     // 64: CreateReservationRequest
+	(*CreateReservationResponse)(nil),    // 65: CreateReservationResponse
+	(*CancelReservationRequest)(nil),     // 66: CancelReservationRequest
+	(*CancelReservationResponse)(nil),    // 67: CancelReservationResponse
+	(*ListCarReservationsRequest)(nil),   // 68: ListCarReservationsRequest
+	(*ListUserReservationsRequest)(nil),  // 69: ListUserReservationsRequest
+	(*ListReservationsResponse)(nil),     // 70: ListReservationsResponse
+	(*Brand)(nil),                        // 71: Brand
+	(*CreateBrandRequest)(nil),           // 72: CreateBrandRequest
+	(*CreateBrandResponse)(nil),          // 73: CreateBrandResponse
+	(*GetBrandRequest)(nil),              // 74: GetBrandRequest
+	(*GetBrandResponse)(nil),             // 75: GetBrandResponse
+	(*ListBrandsRequest)(nil),            // 76: ListBrandsRequest
+	(*ListBrandsResponse)(nil),           // 77: ListBrandsResponse
+	(*UpdateBrandRequest)(nil),           // 78: UpdateBrandRequest
+	(*UpdateBrandResponse)(nil),          // 79: UpdateBrandResponse
+	(*DeleteBrandRequest)(nil),           // 80: DeleteBrandRequest
+	(*DeleteBrandResponse)(nil),          // 81: DeleteBrandResponse
+	(*SignUpUserRequest)(nil),            // 82: SignUpUserRequest
+	(*SignUpUserResponse)(nil),           // 83: SignUpUserResponse
+	(*SignUpAdminRequest)(nil),           // 84: SignUpAdminRequest
+	(*SignUpAdminResponse)(nil),          // 85: SignUpAdminResponse
+	(*GetByLoginRequest)(nil),            // 86: GetByLoginRequest
+	(*GetByLoginResponse)(nil),           // 87: GetByLoginResponse
+	(*RefreshTokenRequest)(nil),          // 88: RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),         // 89: RefreshTokenResponse
+	nil,                                  // 90: Car.LabelsEntry
+	(*timestamppb.Timestamp)(nil),        // 91: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 92: google.protobuf.FieldMask
+	(*wrapperspb.BoolValue)(nil),         // 93: google.protobuf.BoolValue
+}
+var file_services_proto_depIdxs = []int32{
+	16,  // 0: Car.ID:type_name -> UUID
+	16,  // 1: Car.OwnerID:type_name -> UUID
+	90,  // 2: Car.Labels:type_name -> Car.LabelsEntry
+	16,  // 3: Car.BrandID:type_name -> UUID
+	16,  // 4: User.ID:type_name -> UUID
+	16,  // 5: UploadImageRequest.carID:type_name -> UUID
+	11,  // 6: UploadImageResponse.image:type_name -> CarImage
+	16,  // 7: CarImage.ID:type_name -> UUID
+	16,  // 8: CarImage.carID:type_name -> UUID
+	91,  // 9: CarImage.createdAt:type_name -> google.protobuf.Timestamp
+	16,  // 10: ListCarImagesRequest.carID:type_name -> UUID
+	11,  // 11: ListCarImagesResponse.images:type_name -> CarImage
+	16,  // 12: SetPrimaryCarImageRequest.carID:type_name -> UUID
+	16,  // 13: SetPrimaryCarImageRequest.imageID:type_name -> UUID
+	11,  // 14: SetPrimaryCarImageResponse.images:type_name -> CarImage
+	5,   // 15: CreateCarRequest.car:type_name -> Car
+	5,   // 16: CreateCarResponse.car:type_name -> Car
+	16,  // 17: GetCarRequest.ID:type_name -> UUID
+	5,   // 18: GetCarResponse.car:type_name -> Car
+	16,  // 19: DeleteCarRequest.ID:type_name -> UUID
+	16,  // 20: DeleteCarResponse.ID:type_name -> UUID
+	5,   // 21: UpdateCarRequest.car:type_name -> Car
+	92,  // 22: UpdateCarRequest.updateMask:type_name -> google.protobuf.FieldMask
+	5,   // 23: UpdateCarResponse.car:type_name -> Car
+	93,  // 24: GetAllCarsRequest.isRunning:type_name -> google.protobuf.BoolValue
+	0,   // 25: GetAllCarsRequest.sortBy:type_name -> CarSortField
+	5,   // 26: GetAllCarsResponse.cars:type_name -> Car
+	93,  // 27: ListCarsRequest.isRunning:type_name -> google.protobuf.BoolValue
+	0,   // 28: ListCarsRequest.sortBy:type_name -> CarSortField
+	16,  // 29: RestoreCarRequest.ID:type_name -> UUID
+	5,   // 30: RestoreCarResponse.car:type_name -> Car
+	16,  // 31: CarChange.ID:type_name -> UUID
+	16,  // 32: CarChange.carID:type_name -> UUID
+	16,  // 33: CarChange.actorID:type_name -> UUID
+	1,   // 34: CarChange.action:type_name -> CarChangeAction
+	91,  // 35: CarChange.changedAt:type_name -> google.protobuf.Timestamp
+	5,   // 36: CarChange.before:type_name -> Car
+	5,   // 37: CarChange.after:type_name -> Car
+	16,  // 38: GetCarHistoryRequest.carID:type_name -> UUID
+	32,  // 39: GetCarHistoryResponse.changes:type_name -> CarChange
+	5,   // 40: BatchCreateCarsRequest.cars:type_name -> Car
+	5,   // 41: BatchCreateCarsResponse.cars:type_name -> Car
+	23,  // 42: BatchUpdateCarsRequest.requests:type_name -> UpdateCarRequest
+	5,   // 43: BatchUpdateCarsResponse.cars:type_name -> Car
+	16,  // 44: BatchDeleteCarsRequest.IDs:type_name -> UUID
+	16,  // 45: BatchDeleteCarsResponse.IDs:type_name -> UUID
+	2,   // 46: ImportCarsRequest.format:type_name -> CarDataFormat
+	3,   // 47: ImportCarsRowIssue.status:type_name -> CarImportStatus
+	42,  // 48: ImportCarsResponse.issues:type_name -> ImportCarsRowIssue
+	93,  // 49: ExportCarsRequest.isRunning:type_name -> google.protobuf.BoolValue
+	0,   // 50: ExportCarsRequest.sortBy:type_name -> CarSortField
+	2,   // 51: ExportCarsRequest.format:type_name -> CarDataFormat
+	5,   // 52: GetCarByVINResponse.car:type_name -> Car
+	16,  // 53: WatchCarsRequest.carID:type_name -> UUID
+	50,  // 54: GetCarStatsResponse.brands:type_name -> BrandCount
+	51,  // 55: GetCarStatsResponse.productionYears:type_name -> ProductionYearCount
+	16,  // 56: MaintenanceRecord.ID:type_name -> UUID
+	16,  // 57: MaintenanceRecord.carID:type_name -> UUID
+	4,   // 58: MaintenanceRecord.kind:type_name -> MaintenanceKind
+	91,  // 59: MaintenanceRecord.performedAt:type_name -> google.protobuf.Timestamp
+	91,  // 60: MaintenanceRecord.closedAt:type_name -> google.protobuf.Timestamp
+	53,  // 61: AddMaintenanceRequest.record:type_name -> MaintenanceRecord
+	53,  // 62: AddMaintenanceResponse.record:type_name -> MaintenanceRecord
+	16,  // 63: CloseMaintenanceRequest.ID:type_name -> UUID
+	53,  // 64: CloseMaintenanceResponse.record:type_name -> MaintenanceRecord
+	16,  // 65: ListMaintenanceRequest.carID:type_name -> UUID
+	53,  // 66: ListMaintenanceResponse.records:type_name -> MaintenanceRecord
+	5,   // 67: ServiceDue.car:type_name -> Car
+	91,  // 68: ServiceDue.lastServicedAt:type_name -> google.protobuf.Timestamp
+	61,  // 69: GetCarsDueForServiceResponse.cars:type_name -> ServiceDue
+	16,  // 70: Reservation.ID:type_name -> UUID
+	16,  // 71: Reservation.carID:type_name -> UUID
+	16,  // 72: Reservation.userID:type_name -> UUID
+	91,  // 73: Reservation.startsAt:type_name -> google.protobuf.Timestamp
+	91,  // 74: Reservation.endsAt:type_name -> google.protobuf.Timestamp
+	91,  // 75: Reservation.createdAt:type_name -> google.protobuf.Timestamp
+	91,  // 76: Reservation.canceledAt:type_name -> google.protobuf.Timestamp
+	16,  // 77: CreateReservationRequest.carID:type_name -> UUID
+	91,  // 78: CreateReservationRequest.startsAt:type_name -> google.protobuf.Timestamp
+	91,  // 79: CreateReservationRequest.endsAt:type_name -> google.protobuf.Timestamp
+	63,  // 80: CreateReservationResponse.reservation:type_name -> Reservation
+	16,  // 81: CancelReservationRequest.ID:type_name -> UUID
+	63,  // 82: CancelReservationResponse.reservation:type_name -> Reservation
+	16,  // 83: ListCarReservationsRequest.carID:type_name -> UUID
+	16,  // 84: ListUserReservationsRequest.userID:type_name -> UUID
+	63,  // 85: ListReservationsResponse.reservations:type_name -> Reservation
+	16,  // 86: Brand.ID:type_name -> UUID
+	71,  // 87: CreateBrandResponse.brand:type_name -> Brand
+	16,  // 88: GetBrandRequest.ID:type_name -> UUID
+	71,  // 89: GetBrandResponse.brand:type_name -> Brand
+	71,  // 90: ListBrandsResponse.brands:type_name -> Brand
+	16,  // 91: UpdateBrandRequest.ID:type_name -> UUID
+	71,  // 92: UpdateBrandResponse.brand:type_name -> Brand
+	16,  // 93: DeleteBrandRequest.ID:type_name -> UUID
+	17,  // 94: CarService.CreateCar:input_type -> CreateCarRequest
+	19,  // 95: CarService.GetCar:input_type -> GetCarRequest
+	21,  // 96: CarService.DeleteCar:input_type -> DeleteCarRequest
+	23,  // 97: CarService.UpdateCar:input_type -> UpdateCarRequest
+	25,  // 98: CarService.GetAllCars:input_type -> GetAllCarsRequest
+	27,  // 99: CarService.ListCars:input_type -> ListCarsRequest
+	28,  // 100: CarService.RestoreCar:input_type -> RestoreCarRequest
+	30,  // 101: CarService.PurgeDeletedCars:input_type -> PurgeDeletedCarsRequest
+	33,  // 102: CarService.GetCarHistory:input_type -> GetCarHistoryRequest
+	35,  // 103: CarService.BatchCreateCars:input_type -> BatchCreateCarsRequest
+	37,  // 104: CarService.BatchUpdateCars:input_type -> BatchUpdateCarsRequest
+	39,  // 105: CarService.BatchDeleteCars:input_type -> BatchDeleteCarsRequest
+	41,  // 106: CarService.ImportCars:input_type -> ImportCarsRequest
+	44,  // 107: CarService.ExportCars:input_type -> ExportCarsRequest
+	46,  // 108: CarService.GetCarByVIN:input_type -> GetCarByVINRequest
+	48,  // 109: CarService.WatchCars:input_type -> WatchCarsRequest
+	49,  // 110: CarService.GetCarStats:input_type -> GetCarStatsRequest
+	82,  // 111: UserService.SignUpUser:input_type -> SignUpUserRequest
+	84,  // 112: UserService.SignUpAdmin:input_type -> SignUpAdminRequest
+	86,  // 113: UserService.GetByLogin:input_type -> GetByLoginRequest
+	88,  // 114: UserService.RefreshToken:input_type -> RefreshTokenRequest
+	54,  // 115: MaintenanceService.AddMaintenance:input_type -> AddMaintenanceRequest
+	56,  // 116: MaintenanceService.CloseMaintenance:input_type -> CloseMaintenanceRequest
+	58,  // 117: MaintenanceService.ListMaintenance:input_type -> ListMaintenanceRequest
+	60,  // 118: MaintenanceService.GetCarsDueForService:input_type -> GetCarsDueForServiceRequest
+	64,  // 119: ReservationService.CreateReservation:input_type -> CreateReservationRequest
+	66,  // 120: ReservationService.CancelReservation:input_type -> CancelReservationRequest
+	68,  // 121: ReservationService.ListCarReservations:input_type -> ListCarReservationsRequest
+	69,  // 122: ReservationService.ListUserReservations:input_type -> ListUserReservationsRequest
+	72,  // 123: BrandService.CreateBrand:input_type -> CreateBrandRequest
+	74,  // 124: BrandService.GetBrand:input_type -> GetBrandRequest
+	76,  // 125: BrandService.ListBrands:input_type -> ListBrandsRequest
+	78,  // 126: BrandService.UpdateBrand:input_type -> UpdateBrandRequest
+	80,  // 127: BrandService.DeleteBrand:input_type -> DeleteBrandRequest
+	7,   // 128: ImageService.DownloadImage:input_type -> DownloadImageRequest
+	9,   // 129: ImageService.UploadImage:input_type -> UploadImageRequest
+	12,  // 130: ImageService.ListCarImages:input_type -> ListCarImagesRequest
+	14,  // 131: ImageService.SetPrimaryCarImage:input_type -> SetPrimaryCarImageRequest
+	18,  // 132: CarService.CreateCar:output_type -> CreateCarResponse
+	20,  // 133: CarService.GetCar:output_type -> GetCarResponse
+	22,  // 134: CarService.DeleteCar:output_type -> DeleteCarResponse
+	24,  // 135: CarService.UpdateCar:output_type -> UpdateCarResponse
+	26,  // 136: CarService.GetAllCars:output_type -> GetAllCarsResponse
+	5,   // 137: CarService.ListCars:output_type -> Car
+	29,  // 138: CarService.RestoreCar:output_type -> RestoreCarResponse
+	31,  // 139: CarService.PurgeDeletedCars:output_type -> PurgeDeletedCarsResponse
+	34,  // 140: CarService.GetCarHistory:output_type -> GetCarHistoryResponse
+	36,  // 141: CarService.BatchCreateCars:output_type -> BatchCreateCarsResponse
+	38,  // 142: CarService.BatchUpdateCars:output_type -> BatchUpdateCarsResponse
+	40,  // 143: CarService.BatchDeleteCars:output_type -> BatchDeleteCarsResponse
+	43,  // 144: CarService.ImportCars:output_type -> ImportCarsResponse
+	45,  // 145: CarService.ExportCars:output_type -> ExportCarsResponse
+	47,  // 146: CarService.GetCarByVIN:output_type -> GetCarByVINResponse
+	32,  // 147: CarService.WatchCars:output_type -> CarChange
+	52,  // 148: CarService.GetCarStats:output_type -> GetCarStatsResponse
+	83,  // 149: UserService.SignUpUser:output_type -> SignUpUserResponse
+	85,  // 150: UserService.SignUpAdmin:output_type -> SignUpAdminResponse
+	87,  // 151: UserService.GetByLogin:output_type -> GetByLoginResponse
+	89,  // 152: UserService.RefreshToken:output_type -> RefreshTokenResponse
+	55,  // 153: MaintenanceService.AddMaintenance:output_type -> AddMaintenanceResponse
+	57,  // 154: MaintenanceService.CloseMaintenance:output_type -> CloseMaintenanceResponse
+	59,  // 155: MaintenanceService.ListMaintenance:output_type -> ListMaintenanceResponse
+	62,  // 156: MaintenanceService.GetCarsDueForService:output_type -> GetCarsDueForServiceResponse
+	65,  // 157: ReservationService.CreateReservation:output_type -> CreateReservationResponse
+	67,  // 158: ReservationService.CancelReservation:output_type -> CancelReservationResponse
+	70,  // 159: ReservationService.ListCarReservations:output_type -> ListReservationsResponse
+	70,  // 160: ReservationService.ListUserReservations:output_type -> ListReservationsResponse
+	73,  // 161: BrandService.CreateBrand:output_type -> CreateBrandResponse
+	75,  // 162: BrandService.GetBrand:output_type -> GetBrandResponse
+	77,  // 163: BrandService.ListBrands:output_type -> ListBrandsResponse
+	79,  // 164: BrandService.UpdateBrand:output_type -> UpdateBrandResponse
+	81,  // 165: BrandService.DeleteBrand:output_type -> DeleteBrandResponse
+	8,   // 166: ImageService.DownloadImage:output_type -> DownloadImageResponse
+	10,  // 167: ImageService.UploadImage:output_type -> UploadImageResponse
+	13,  // 168: ImageService.ListCarImages:output_type -> ListCarImagesResponse
+	15,  // 169: ImageService.SetPrimaryCarImage:output_type -> SetPrimaryCarImageResponse
+	132, // [132:170] is the sub-list for method output_type
+	94,  // [94:132] is the sub-list for method input_type
+	94,  // [94:94] is the sub-list for extension type_name
+	94,  // [94:94] is the sub-list for extension extendee
+	0,   // [0:94] is the sub-list for field type_name
+}
+
+func init() { file_services_proto_init() }
+func file_services_proto_init() {
+	if File_services_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_services_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Car); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCarImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCarImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPrimaryCarImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPrimaryCarImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UUID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllCarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeDeletedCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeDeletedCarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateCarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateCarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteCarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCarsRowIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportCarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarByVINRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarByVINResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BrandCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductionYearCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddMaintenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseMaintenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMaintenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarsDueForServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceDue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
-func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *RefreshTokenRequest) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *RefreshTokenRequest) GetRefreshToken() string {
-	if x != nil {
-		return x.RefreshToken
-	}
-	return ""
-}
-
-type RefreshTokenResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AccessToken  string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
-}
-
-func (x *RefreshTokenResponse) Reset() {
-	*x = RefreshTokenResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_services_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RefreshTokenResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RefreshTokenResponse) ProtoMessage() {}
-
-func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_services_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCarsDueForServiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
-func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_services_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *RefreshTokenResponse) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *RefreshTokenResponse) GetRefreshToken() string {
-	if x != nil {
-		return x.RefreshToken
-	}
-	return ""
-}
-
-var File_services_proto protoreflect.FileDescriptor
-
-var file_services_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x78, 0x0a, 0x03, 0x43, 0x61, 0x72, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14,
-	0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42,
-	0x72, 0x61, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09,
-	0x49, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x49, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x89, 0x01, 0x0a, 0x04, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x69, 0x6d, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x69, 0x6d, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
-	0x69, 0x6d, 0x67, 0x22, 0x26, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6d, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x69, 0x6d, 0x67, 0x22, 0x15, 0x0a, 0x13, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1c, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x2a, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x2b, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04,
-	0x2e, 0x43, 0x61, 0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x26, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02, 0x49,
-	0x44, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x29, 0x0a, 0x10, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x15, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55,
-	0x49, 0x44, 0x52, 0x02, 0x49, 0x44, 0x22, 0x2a, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x52, 0x02,
-	0x49, 0x44, 0x22, 0x2a, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x2b,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x63, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x03, 0x63, 0x61, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x43, 0x61, 0x72, 0x52, 0x04, 0x63, 0x61, 0x72, 0x73,
-	0x22, 0x45, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x55,
-	0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5b, 0x0a, 0x13, 0x53,
-	0x69, 0x67, 0x6e, 0x55, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42,
-	0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f,
-	0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x5a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x13, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x94, 0x02, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x61, 0x72, 0x12, 0x11, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x72, 0x12, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x61, 0x72, 0x12, 0x11, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
-	0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12, 0x11, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61,
-	0x72, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43,
-	0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfa, 0x01,
-	0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a,
-	0x0a, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x55, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x52,
-	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x2e, 0x52, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x90, 0x01, 0x0a, 0x0c, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x15, 0x2e, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x3c, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x13,
-	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x42, 0x36, 0x5a,
-	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x73, 0x74,
-	0x75, 0x75, 0x72, 0x62, 0x69, 0x61, 0x2f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x41, 0x72, 0x74, 0x79, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_services_proto_rawDescOnce sync.Once
-	file_services_proto_rawDescData = file_services_proto_rawDesc
-)
-
-func file_services_proto_rawDescGZIP() []byte {
-	file_services_proto_rawDescOnce.Do(func() {
-		file_services_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_proto_rawDescData)
-	})
-	return file_services_proto_rawDescData
-}
-
-var file_services_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_services_proto_goTypes = []interface{}{
-	(*Car)(nil),                   // 0: Car
-	(*User)(nil),                  // 1: User
-	(*DownloadImageRequest)(nil),  // 2: DownloadImageRequest
-	(*DownloadImageResponse)(nil), // 3: DownloadImageResponse
-	(*UploadImageRequest)(nil),    // 4: UploadImageRequest
-	(*UploadImageResponse)(nil),   // 5: UploadImageResponse
-	(*UUID)(nil),                  // 6: UUID
-	(*CreateCarRequest)(nil),      // 7: CreateCarRequest
-	(*CreateCarResponse)(nil),     // 8: CreateCarResponse
-	(*GetCarRequest)(nil),         // 9: GetCarRequest
-	(*GetCarResponse)(nil),        // 10: GetCarResponse
-	(*DeleteCarRequest)(nil),      // 11: DeleteCarRequest
-	(*DeleteCarResponse)(nil),     // 12: DeleteCarResponse
-	(*UpdateCarRequest)(nil),      // 13: UpdateCarRequest
-	(*UpdateCarResponse)(nil),     // 14: UpdateCarResponse
-	(*GetAllCarsRequest)(nil),     // 15: GetAllCarsRequest
-	(*GetAllCarsResponse)(nil),    // 16: GetAllCarsResponse
-	(*SignUpUserRequest)(nil),     // 17: SignUpUserRequest
-	(*SignUpUserResponse)(nil),    // 18: SignUpUserResponse
-	(*SignUpAdminRequest)(nil),    // 19: SignUpAdminRequest
-	(*SignUpAdminResponse)(nil),   // 20: SignUpAdminResponse
-	(*GetByLoginRequest)(nil),     // 21: GetByLoginRequest
-	(*GetByLoginResponse)(nil),    // 22: GetByLoginResponse
-	(*RefreshTokenRequest)(nil),   // 23: RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),  // 24: RefreshTokenResponse
-}
-var file_services_proto_depIdxs = []int32{
-	6,  // 0: Car.ID:type_name -> UUID
-	6,  // 1: User.ID:type_name -> UUID
-	0,  // 2: CreateCarRequest.car:type_name -> Car
-	0,  // 3: CreateCarResponse.car:type_name -> Car
-	6,  // 4: GetCarRequest.ID:type_name -> UUID
-	0,  // 5: GetCarResponse.car:type_name -> Car
-	6,  // 6: DeleteCarRequest.ID:type_name -> UUID
-	6,  // 7: DeleteCarResponse.ID:type_name -> UUID
-	0,  // 8: UpdateCarRequest.car:type_name -> Car
-	0,  // 9: UpdateCarResponse.car:type_name -> Car
-	0,  // 10: GetAllCarsResponse.cars:type_name -> Car
-	7,  // 11: CarService.CreateCar:input_type -> CreateCarRequest
-	9,  // 12: CarService.GetCar:input_type -> GetCarRequest
-	11, // 13: CarService.DeleteCar:input_type -> DeleteCarRequest
-	13, // 14: CarService.UpdateCar:input_type -> UpdateCarRequest
-	15, // 15: CarService.GetAllCars:input_type -> GetAllCarsRequest
-	17, // 16: UserService.SignUpUser:input_type -> SignUpUserRequest
-	19, // 17: UserService.SignUpAdmin:input_type -> SignUpAdminRequest
-	21, // 18: UserService.GetByLogin:input_type -> GetByLoginRequest
-	23, // 19: UserService.RefreshToken:input_type -> RefreshTokenRequest
-	2,  // 20: ImageService.DownloadImage:input_type -> DownloadImageRequest
-	4,  // 21: ImageService.UploadImage:input_type -> UploadImageRequest
-	8,  // 22: CarService.CreateCar:output_type -> CreateCarResponse
-	10, // 23: CarService.GetCar:output_type -> GetCarResponse
-	12, // 24: CarService.DeleteCar:output_type -> DeleteCarResponse
-	14, // 25: CarService.UpdateCar:output_type -> UpdateCarResponse
-	16, // 26: CarService.GetAllCars:output_type -> GetAllCarsResponse
-	18, // 27: UserService.SignUpUser:output_type -> SignUpUserResponse
-	20, // 28: UserService.SignUpAdmin:output_type -> SignUpAdminResponse
-	22, // 29: UserService.GetByLogin:output_type -> GetByLoginResponse
-	24, // 30: UserService.RefreshToken:output_type -> RefreshTokenResponse
-	3,  // 31: ImageService.DownloadImage:output_type -> DownloadImageResponse
-	5,  // 32: ImageService.UploadImage:output_type -> UploadImageResponse
-	22, // [22:33] is the sub-list for method output_type
-	11, // [11:22] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
-}
-
-func init() { file_services_proto_init() }
-func file_services_proto_init() {
-	if File_services_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_services_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Car); i {
+		file_services_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reservation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1545,8 +6808,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_services_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReservationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1557,8 +6820,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadImageRequest); i {
+		file_services_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReservationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1569,8 +6832,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadImageResponse); i {
+		file_services_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1581,8 +6844,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadImageRequest); i {
+		file_services_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReservationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1593,8 +6856,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadImageResponse); i {
+		file_services_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCarReservationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1605,8 +6868,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UUID); i {
+		file_services_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserReservationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1617,8 +6880,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCarRequest); i {
+		file_services_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReservationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1629,8 +6892,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCarResponse); i {
+		file_services_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Brand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1641,8 +6904,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCarRequest); i {
+		file_services_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBrandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1653,8 +6916,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCarResponse); i {
+		file_services_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBrandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1665,8 +6928,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCarRequest); i {
+		file_services_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBrandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1677,8 +6940,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCarResponse); i {
+		file_services_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBrandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1689,8 +6952,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCarRequest); i {
+		file_services_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBrandsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1701,8 +6964,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCarResponse); i {
+		file_services_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBrandsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1713,8 +6976,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllCarsRequest); i {
+		file_services_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBrandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1725,8 +6988,8 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllCarsResponse); i {
+		file_services_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBrandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1737,7 +7000,31 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_services_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBrandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBrandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignUpUserRequest); i {
 			case 0:
 				return &v.state
@@ -1749,7 +7036,7 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_services_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignUpUserResponse); i {
 			case 0:
 				return &v.state
@@ -1761,7 +7048,7 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_services_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignUpAdminRequest); i {
 			case 0:
 				return &v.state
@@ -1773,7 +7060,7 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_services_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignUpAdminResponse); i {
 			case 0:
 				return &v.state
@@ -1785,7 +7072,7 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_services_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetByLoginRequest); i {
 			case 0:
 				return &v.state
@@ -1797,7 +7084,7 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_services_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetByLoginResponse); i {
 			case 0:
 				return &v.state
@@ -1809,7 +7096,7 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_services_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshTokenRequest); i {
 			case 0:
 				return &v.state
@@ -1821,7 +7108,7 @@ func file_services_proto_init() {
 				return nil
 			}
 		}
-		file_services_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_services_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshTokenResponse); i {
 			case 0:
 				return &v.state
@@ -1839,13 +7126,14 @@ func file_services_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   25,
+			NumEnums:      5,
+			NumMessages:   86,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   6,
 		},
 		GoTypes:           file_services_proto_goTypes,
 		DependencyIndexes: file_services_proto_depIdxs,
+		EnumInfos:         file_services_proto_enumTypes,
 		MessageInfos:      file_services_proto_msgTypes,
 	}.Build()
 	File_services_proto = out.File
@@ -27,6 +27,18 @@ type CarServiceClient interface {
 	DeleteCar(ctx context.Context, in *DeleteCarRequest, opts ...grpc.CallOption) (*DeleteCarResponse, error)
 	UpdateCar(ctx context.Context, in *UpdateCarRequest, opts ...grpc.CallOption) (*UpdateCarResponse, error)
 	GetAllCars(ctx context.Context, in *GetAllCarsRequest, opts ...grpc.CallOption) (*GetAllCarsResponse, error)
+	ListCars(ctx context.Context, in *ListCarsRequest, opts ...grpc.CallOption) (CarService_ListCarsClient, error)
+	RestoreCar(ctx context.Context, in *RestoreCarRequest, opts ...grpc.CallOption) (*RestoreCarResponse, error)
+	PurgeDeletedCars(ctx context.Context, in *PurgeDeletedCarsRequest, opts ...grpc.CallOption) (*PurgeDeletedCarsResponse, error)
+	GetCarHistory(ctx context.Context, in *GetCarHistoryRequest, opts ...grpc.CallOption) (*GetCarHistoryResponse, error)
+	BatchCreateCars(ctx context.Context, in *BatchCreateCarsRequest, opts ...grpc.CallOption) (*BatchCreateCarsResponse, error)
+	BatchUpdateCars(ctx context.Context, in *BatchUpdateCarsRequest, opts ...grpc.CallOption) (*BatchUpdateCarsResponse, error)
+	BatchDeleteCars(ctx context.Context, in *BatchDeleteCarsRequest, opts ...grpc.CallOption) (*BatchDeleteCarsResponse, error)
+	ImportCars(ctx context.Context, opts ...grpc.CallOption) (CarService_ImportCarsClient, error)
+	ExportCars(ctx context.Context, in *ExportCarsRequest, opts ...grpc.CallOption) (CarService_ExportCarsClient, error)
+	GetCarByVIN(ctx context.Context, in *GetCarByVINRequest, opts ...grpc.CallOption) (*GetCarByVINResponse, error)
+	WatchCars(ctx context.Context, in *WatchCarsRequest, opts ...grpc.CallOption) (CarService_WatchCarsClient, error)
+	GetCarStats(ctx context.Context, in *GetCarStatsRequest, opts ...grpc.CallOption) (*GetCarStatsResponse, error)
 }
 
 type carServiceClient struct {
@@ -82,6 +94,208 @@ func (c *carServiceClient) GetAllCars(ctx context.Context, in *GetAllCarsRequest
 	return out, nil
 }
 
+func (c *carServiceClient) ListCars(ctx context.Context, in *ListCarsRequest, opts ...grpc.CallOption) (CarService_ListCarsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CarService_ServiceDesc.Streams[0], "/CarService/ListCars", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &carServiceListCarsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CarService_ListCarsClient interface {
+	Recv() (*Car, error)
+	grpc.ClientStream
+}
+
+type carServiceListCarsClient struct {
+	grpc.ClientStream
+}
+
+func (x *carServiceListCarsClient) Recv() (*Car, error) {
+	m := new(Car)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *carServiceClient) RestoreCar(ctx context.Context, in *RestoreCarRequest, opts ...grpc.CallOption) (*RestoreCarResponse, error) {
+	out := new(RestoreCarResponse)
+	err := c.cc.Invoke(ctx, "/CarService/RestoreCar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) PurgeDeletedCars(ctx context.Context, in *PurgeDeletedCarsRequest, opts ...grpc.CallOption) (*PurgeDeletedCarsResponse, error) {
+	out := new(PurgeDeletedCarsResponse)
+	err := c.cc.Invoke(ctx, "/CarService/PurgeDeletedCars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) GetCarHistory(ctx context.Context, in *GetCarHistoryRequest, opts ...grpc.CallOption) (*GetCarHistoryResponse, error) {
+	out := new(GetCarHistoryResponse)
+	err := c.cc.Invoke(ctx, "/CarService/GetCarHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) BatchCreateCars(ctx context.Context, in *BatchCreateCarsRequest, opts ...grpc.CallOption) (*BatchCreateCarsResponse, error) {
+	out := new(BatchCreateCarsResponse)
+	err := c.cc.Invoke(ctx, "/CarService/BatchCreateCars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) BatchUpdateCars(ctx context.Context, in *BatchUpdateCarsRequest, opts ...grpc.CallOption) (*BatchUpdateCarsResponse, error) {
+	out := new(BatchUpdateCarsResponse)
+	err := c.cc.Invoke(ctx, "/CarService/BatchUpdateCars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) BatchDeleteCars(ctx context.Context, in *BatchDeleteCarsRequest, opts ...grpc.CallOption) (*BatchDeleteCarsResponse, error) {
+	out := new(BatchDeleteCarsResponse)
+	err := c.cc.Invoke(ctx, "/CarService/BatchDeleteCars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) ImportCars(ctx context.Context, opts ...grpc.CallOption) (CarService_ImportCarsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CarService_ServiceDesc.Streams[1], "/CarService/ImportCars", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &carServiceImportCarsClient{stream}
+	return x, nil
+}
+
+type CarService_ImportCarsClient interface {
+	Send(*ImportCarsRequest) error
+	CloseAndRecv() (*ImportCarsResponse, error)
+	grpc.ClientStream
+}
+
+type carServiceImportCarsClient struct {
+	grpc.ClientStream
+}
+
+func (x *carServiceImportCarsClient) Send(m *ImportCarsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *carServiceImportCarsClient) CloseAndRecv() (*ImportCarsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportCarsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *carServiceClient) ExportCars(ctx context.Context, in *ExportCarsRequest, opts ...grpc.CallOption) (CarService_ExportCarsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CarService_ServiceDesc.Streams[2], "/CarService/ExportCars", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &carServiceExportCarsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CarService_ExportCarsClient interface {
+	Recv() (*ExportCarsResponse, error)
+	grpc.ClientStream
+}
+
+type carServiceExportCarsClient struct {
+	grpc.ClientStream
+}
+
+func (x *carServiceExportCarsClient) Recv() (*ExportCarsResponse, error) {
+	m := new(ExportCarsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *carServiceClient) GetCarByVIN(ctx context.Context, in *GetCarByVINRequest, opts ...grpc.CallOption) (*GetCarByVINResponse, error) {
+	out := new(GetCarByVINResponse)
+	err := c.cc.Invoke(ctx, "/CarService/GetCarByVIN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) WatchCars(ctx context.Context, in *WatchCarsRequest, opts ...grpc.CallOption) (CarService_WatchCarsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CarService_ServiceDesc.Streams[3], "/CarService/WatchCars", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &carServiceWatchCarsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CarService_WatchCarsClient interface {
+	Recv() (*CarChange, error)
+	grpc.ClientStream
+}
+
+type carServiceWatchCarsClient struct {
+	grpc.ClientStream
+}
+
+func (x *carServiceWatchCarsClient) Recv() (*CarChange, error) {
+	m := new(CarChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *carServiceClient) GetCarStats(ctx context.Context, in *GetCarStatsRequest, opts ...grpc.CallOption) (*GetCarStatsResponse, error) {
+	out := new(GetCarStatsResponse)
+	err := c.cc.Invoke(ctx, "/CarService/GetCarStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CarServiceServer is the server API for CarService service.
 // All implementations must embed UnimplementedCarServiceServer
 // for forward compatibility
@@ -91,351 +305,1303 @@ type CarServiceServer interface {
 	DeleteCar(context.Context, *DeleteCarRequest) (*DeleteCarResponse, error)
 	UpdateCar(context.Context, *UpdateCarRequest) (*UpdateCarResponse, error)
 	GetAllCars(context.Context, *GetAllCarsRequest) (*GetAllCarsResponse, error)
+	ListCars(*ListCarsRequest, CarService_ListCarsServer) error
+	RestoreCar(context.Context, *RestoreCarRequest) (*RestoreCarResponse, error)
+	PurgeDeletedCars(context.Context, *PurgeDeletedCarsRequest) (*PurgeDeletedCarsResponse, error)
+	GetCarHistory(context.Context, *GetCarHistoryRequest) (*GetCarHistoryResponse, error)
+	BatchCreateCars(context.Context, *BatchCreateCarsRequest) (*BatchCreateCarsResponse, error)
+	BatchUpdateCars(context.Context, *BatchUpdateCarsRequest) (*BatchUpdateCarsResponse, error)
+	BatchDeleteCars(context.Context, *BatchDeleteCarsRequest) (*BatchDeleteCarsResponse, error)
+	ImportCars(CarService_ImportCarsServer) error
+	ExportCars(*ExportCarsRequest, CarService_ExportCarsServer) error
+	GetCarByVIN(context.Context, *GetCarByVINRequest) (*GetCarByVINResponse, error)
+	WatchCars(*WatchCarsRequest, CarService_WatchCarsServer) error
+	GetCarStats(context.Context, *GetCarStatsRequest) (*GetCarStatsResponse, error)
+	mustEmbedUnimplementedCarServiceServer()
+}
+
+// UnimplementedCarServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCarServiceServer struct {
+}
+
+func (UnimplementedCarServiceServer) CreateCar(context.Context, *CreateCarRequest) (*CreateCarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCar not implemented")
+}
+func (UnimplementedCarServiceServer) GetCar(context.Context, *GetCarRequest) (*GetCarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCar not implemented")
+}
+func (UnimplementedCarServiceServer) DeleteCar(context.Context, *DeleteCarRequest) (*DeleteCarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCar not implemented")
+}
+func (UnimplementedCarServiceServer) UpdateCar(context.Context, *UpdateCarRequest) (*UpdateCarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCar not implemented")
+}
+func (UnimplementedCarServiceServer) GetAllCars(context.Context, *GetAllCarsRequest) (*GetAllCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCars not implemented")
+}
+func (UnimplementedCarServiceServer) ListCars(*ListCarsRequest, CarService_ListCarsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListCars not implemented")
+}
+func (UnimplementedCarServiceServer) RestoreCar(context.Context, *RestoreCarRequest) (*RestoreCarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCar not implemented")
+}
+func (UnimplementedCarServiceServer) PurgeDeletedCars(context.Context, *PurgeDeletedCarsRequest) (*PurgeDeletedCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeletedCars not implemented")
+}
+func (UnimplementedCarServiceServer) GetCarHistory(context.Context, *GetCarHistoryRequest) (*GetCarHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCarHistory not implemented")
+}
+func (UnimplementedCarServiceServer) BatchCreateCars(context.Context, *BatchCreateCarsRequest) (*BatchCreateCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateCars not implemented")
+}
+func (UnimplementedCarServiceServer) BatchUpdateCars(context.Context, *BatchUpdateCarsRequest) (*BatchUpdateCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCars not implemented")
+}
+func (UnimplementedCarServiceServer) BatchDeleteCars(context.Context, *BatchDeleteCarsRequest) (*BatchDeleteCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteCars not implemented")
+}
+func (UnimplementedCarServiceServer) ImportCars(CarService_ImportCarsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCars not implemented")
+}
+func (UnimplementedCarServiceServer) ExportCars(*ExportCarsRequest, CarService_ExportCarsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCars not implemented")
+}
+func (UnimplementedCarServiceServer) GetCarByVIN(context.Context, *GetCarByVINRequest) (*GetCarByVINResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCarByVIN not implemented")
+}
+func (UnimplementedCarServiceServer) WatchCars(*WatchCarsRequest, CarService_WatchCarsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCars not implemented")
+}
+func (UnimplementedCarServiceServer) GetCarStats(context.Context, *GetCarStatsRequest) (*GetCarStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCarStats not implemented")
+}
+func (UnimplementedCarServiceServer) mustEmbedUnimplementedCarServiceServer() {}
+
+// UnsafeCarServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CarServiceServer will
+// result in compilation errors.
+type UnsafeCarServiceServer interface {
 	mustEmbedUnimplementedCarServiceServer()
 }
 
-// UnimplementedCarServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedCarServiceServer struct {
+func RegisterCarServiceServer(s grpc.ServiceRegistrar, srv CarServiceServer) {
+	s.RegisterService(&CarService_ServiceDesc, srv)
+}
+
+func _CarService_CreateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).CreateCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/CreateCar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).CreateCar(ctx, req.(*CreateCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_GetCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).GetCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/GetCar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).GetCar(ctx, req.(*GetCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_DeleteCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).DeleteCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/DeleteCar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).DeleteCar(ctx, req.(*DeleteCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_UpdateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).UpdateCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/UpdateCar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).UpdateCar(ctx, req.(*UpdateCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_GetAllCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).GetAllCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/GetAllCars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).GetAllCars(ctx, req.(*GetAllCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_ListCars_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCarsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CarServiceServer).ListCars(m, &carServiceListCarsServer{stream})
+}
+
+type CarService_ListCarsServer interface {
+	Send(*Car) error
+	grpc.ServerStream
+}
+
+type carServiceListCarsServer struct {
+	grpc.ServerStream
+}
+
+func (x *carServiceListCarsServer) Send(m *Car) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CarService_RestoreCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).RestoreCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/RestoreCar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).RestoreCar(ctx, req.(*RestoreCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_PurgeDeletedCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeletedCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).PurgeDeletedCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/PurgeDeletedCars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).PurgeDeletedCars(ctx, req.(*PurgeDeletedCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_GetCarHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).GetCarHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/GetCarHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).GetCarHistory(ctx, req.(*GetCarHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_BatchCreateCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).BatchCreateCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/BatchCreateCars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).BatchCreateCars(ctx, req.(*BatchCreateCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_BatchUpdateCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).BatchUpdateCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/BatchUpdateCars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).BatchUpdateCars(ctx, req.(*BatchUpdateCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_BatchDeleteCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).BatchDeleteCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/BatchDeleteCars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).BatchDeleteCars(ctx, req.(*BatchDeleteCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_ImportCars_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CarServiceServer).ImportCars(&carServiceImportCarsServer{stream})
+}
+
+type CarService_ImportCarsServer interface {
+	SendAndClose(*ImportCarsResponse) error
+	Recv() (*ImportCarsRequest, error)
+	grpc.ServerStream
+}
+
+type carServiceImportCarsServer struct {
+	grpc.ServerStream
+}
+
+func (x *carServiceImportCarsServer) SendAndClose(m *ImportCarsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *carServiceImportCarsServer) Recv() (*ImportCarsRequest, error) {
+	m := new(ImportCarsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CarService_ExportCars_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCarsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CarServiceServer).ExportCars(m, &carServiceExportCarsServer{stream})
+}
+
+type CarService_ExportCarsServer interface {
+	Send(*ExportCarsResponse) error
+	grpc.ServerStream
+}
+
+type carServiceExportCarsServer struct {
+	grpc.ServerStream
+}
+
+func (x *carServiceExportCarsServer) Send(m *ExportCarsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CarService_GetCarByVIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarByVINRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).GetCarByVIN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/GetCarByVIN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).GetCarByVIN(ctx, req.(*GetCarByVINRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_WatchCars_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCarsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CarServiceServer).WatchCars(m, &carServiceWatchCarsServer{stream})
+}
+
+type CarService_WatchCarsServer interface {
+	Send(*CarChange) error
+	grpc.ServerStream
+}
+
+type carServiceWatchCarsServer struct {
+	grpc.ServerStream
+}
+
+func (x *carServiceWatchCarsServer) Send(m *CarChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CarService_GetCarStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).GetCarStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CarService/GetCarStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).GetCarStats(ctx, req.(*GetCarStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CarService_ServiceDesc is the grpc.ServiceDesc for CarService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CarService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "CarService",
+	HandlerType: (*CarServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCar",
+			Handler:    _CarService_CreateCar_Handler,
+		},
+		{
+			MethodName: "GetCar",
+			Handler:    _CarService_GetCar_Handler,
+		},
+		{
+			MethodName: "DeleteCar",
+			Handler:    _CarService_DeleteCar_Handler,
+		},
+		{
+			MethodName: "UpdateCar",
+			Handler:    _CarService_UpdateCar_Handler,
+		},
+		{
+			MethodName: "GetAllCars",
+			Handler:    _CarService_GetAllCars_Handler,
+		},
+		{
+			MethodName: "RestoreCar",
+			Handler:    _CarService_RestoreCar_Handler,
+		},
+		{
+			MethodName: "PurgeDeletedCars",
+			Handler:    _CarService_PurgeDeletedCars_Handler,
+		},
+		{
+			MethodName: "GetCarHistory",
+			Handler:    _CarService_GetCarHistory_Handler,
+		},
+		{
+			MethodName: "BatchCreateCars",
+			Handler:    _CarService_BatchCreateCars_Handler,
+		},
+		{
+			MethodName: "BatchUpdateCars",
+			Handler:    _CarService_BatchUpdateCars_Handler,
+		},
+		{
+			MethodName: "BatchDeleteCars",
+			Handler:    _CarService_BatchDeleteCars_Handler,
+		},
+		{
+			MethodName: "GetCarByVIN",
+			Handler:    _CarService_GetCarByVIN_Handler,
+		},
+		{
+			MethodName: "GetCarStats",
+			Handler:    _CarService_GetCarStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListCars",
+			Handler:       _CarService_ListCars_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportCars",
+			Handler:       _CarService_ImportCars_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCars",
+			Handler:       _CarService_ExportCars_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCars",
+			Handler:       _CarService_WatchCars_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserServiceClient interface {
+	SignUpUser(ctx context.Context, in *SignUpUserRequest, opts ...grpc.CallOption) (*SignUpUserResponse, error)
+	SignUpAdmin(ctx context.Context, in *SignUpAdminRequest, opts ...grpc.CallOption) (*SignUpAdminResponse, error)
+	GetByLogin(ctx context.Context, in *GetByLoginRequest, opts ...grpc.CallOption) (*GetByLoginResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) SignUpUser(ctx context.Context, in *SignUpUserRequest, opts ...grpc.CallOption) (*SignUpUserResponse, error) {
+	out := new(SignUpUserResponse)
+	err := c.cc.Invoke(ctx, "/UserService/SignUpUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SignUpAdmin(ctx context.Context, in *SignUpAdminRequest, opts ...grpc.CallOption) (*SignUpAdminResponse, error) {
+	out := new(SignUpAdminResponse)
+	err := c.cc.Invoke(ctx, "/UserService/SignUpAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetByLogin(ctx context.Context, in *GetByLoginRequest, opts ...grpc.CallOption) (*GetByLoginResponse, error) {
+	out := new(GetByLoginResponse)
+	err := c.cc.Invoke(ctx, "/UserService/GetByLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/UserService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility
+type UserServiceServer interface {
+	SignUpUser(context.Context, *SignUpUserRequest) (*SignUpUserResponse, error)
+	SignUpAdmin(context.Context, *SignUpAdminRequest) (*SignUpAdminResponse, error)
+	GetByLogin(context.Context, *GetByLoginRequest) (*GetByLoginResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserServiceServer struct {
+}
+
+func (UnimplementedUserServiceServer) SignUpUser(context.Context, *SignUpUserRequest) (*SignUpUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUpUser not implemented")
+}
+func (UnimplementedUserServiceServer) SignUpAdmin(context.Context, *SignUpAdminRequest) (*SignUpAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUpAdmin not implemented")
+}
+func (UnimplementedUserServiceServer) GetByLogin(context.Context, *GetByLoginRequest) (*GetByLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByLogin not implemented")
+}
+func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_SignUpUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SignUpUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/SignUpUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SignUpUser(ctx, req.(*SignUpUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SignUpAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SignUpAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/SignUpAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SignUpAdmin(ctx, req.(*SignUpAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetByLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetByLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/GetByLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetByLogin(ctx, req.(*GetByLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/UserService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignUpUser",
+			Handler:    _UserService_SignUpUser_Handler,
+		},
+		{
+			MethodName: "SignUpAdmin",
+			Handler:    _UserService_SignUpAdmin_Handler,
+		},
+		{
+			MethodName: "GetByLogin",
+			Handler:    _UserService_GetByLogin_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _UserService_RefreshToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services.proto",
+}
+
+// MaintenanceServiceClient is the client API for MaintenanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MaintenanceServiceClient interface {
+	AddMaintenance(ctx context.Context, in *AddMaintenanceRequest, opts ...grpc.CallOption) (*AddMaintenanceResponse, error)
+	CloseMaintenance(ctx context.Context, in *CloseMaintenanceRequest, opts ...grpc.CallOption) (*CloseMaintenanceResponse, error)
+	ListMaintenance(ctx context.Context, in *ListMaintenanceRequest, opts ...grpc.CallOption) (*ListMaintenanceResponse, error)
+	GetCarsDueForService(ctx context.Context, in *GetCarsDueForServiceRequest, opts ...grpc.CallOption) (*GetCarsDueForServiceResponse, error)
+}
+
+type maintenanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMaintenanceServiceClient(cc grpc.ClientConnInterface) MaintenanceServiceClient {
+	return &maintenanceServiceClient{cc}
+}
+
+func (c *maintenanceServiceClient) AddMaintenance(ctx context.Context, in *AddMaintenanceRequest, opts ...grpc.CallOption) (*AddMaintenanceResponse, error) {
+	out := new(AddMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/MaintenanceService/AddMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) CloseMaintenance(ctx context.Context, in *CloseMaintenanceRequest, opts ...grpc.CallOption) (*CloseMaintenanceResponse, error) {
+	out := new(CloseMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/MaintenanceService/CloseMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) ListMaintenance(ctx context.Context, in *ListMaintenanceRequest, opts ...grpc.CallOption) (*ListMaintenanceResponse, error) {
+	out := new(ListMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/MaintenanceService/ListMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) GetCarsDueForService(ctx context.Context, in *GetCarsDueForServiceRequest, opts ...grpc.CallOption) (*GetCarsDueForServiceResponse, error) {
+	out := new(GetCarsDueForServiceResponse)
+	err := c.cc.Invoke(ctx, "/MaintenanceService/GetCarsDueForService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MaintenanceServiceServer is the server API for MaintenanceService service.
+// All implementations must embed UnimplementedMaintenanceServiceServer
+// for forward compatibility
+type MaintenanceServiceServer interface {
+	AddMaintenance(context.Context, *AddMaintenanceRequest) (*AddMaintenanceResponse, error)
+	CloseMaintenance(context.Context, *CloseMaintenanceRequest) (*CloseMaintenanceResponse, error)
+	ListMaintenance(context.Context, *ListMaintenanceRequest) (*ListMaintenanceResponse, error)
+	GetCarsDueForService(context.Context, *GetCarsDueForServiceRequest) (*GetCarsDueForServiceResponse, error)
+	mustEmbedUnimplementedMaintenanceServiceServer()
+}
+
+// UnimplementedMaintenanceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMaintenanceServiceServer struct {
+}
+
+func (UnimplementedMaintenanceServiceServer) AddMaintenance(context.Context, *AddMaintenanceRequest) (*AddMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMaintenance not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) CloseMaintenance(context.Context, *CloseMaintenanceRequest) (*CloseMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseMaintenance not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) ListMaintenance(context.Context, *ListMaintenanceRequest) (*ListMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenance not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) GetCarsDueForService(context.Context, *GetCarsDueForServiceRequest) (*GetCarsDueForServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCarsDueForService not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) mustEmbedUnimplementedMaintenanceServiceServer() {}
+
+// UnsafeMaintenanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MaintenanceServiceServer will
+// result in compilation errors.
+type UnsafeMaintenanceServiceServer interface {
+	mustEmbedUnimplementedMaintenanceServiceServer()
+}
+
+func RegisterMaintenanceServiceServer(s grpc.ServiceRegistrar, srv MaintenanceServiceServer) {
+	s.RegisterService(&MaintenanceService_ServiceDesc, srv)
+}
+
+func _MaintenanceService_AddMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).AddMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MaintenanceService/AddMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).AddMaintenance(ctx, req.(*AddMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_CloseMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).CloseMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MaintenanceService/CloseMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).CloseMaintenance(ctx, req.(*CloseMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_ListMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).ListMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MaintenanceService/ListMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).ListMaintenance(ctx, req.(*ListMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_GetCarsDueForService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCarsDueForServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).GetCarsDueForService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MaintenanceService/GetCarsDueForService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).GetCarsDueForService(ctx, req.(*GetCarsDueForServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MaintenanceService_ServiceDesc is the grpc.ServiceDesc for MaintenanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MaintenanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "MaintenanceService",
+	HandlerType: (*MaintenanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddMaintenance",
+			Handler:    _MaintenanceService_AddMaintenance_Handler,
+		},
+		{
+			MethodName: "CloseMaintenance",
+			Handler:    _MaintenanceService_CloseMaintenance_Handler,
+		},
+		{
+			MethodName: "ListMaintenance",
+			Handler:    _MaintenanceService_ListMaintenance_Handler,
+		},
+		{
+			MethodName: "GetCarsDueForService",
+			Handler:    _MaintenanceService_GetCarsDueForService_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services.proto",
+}
+
+// ReservationServiceClient is the client API for ReservationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReservationServiceClient interface {
+	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
+	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error)
+	ListCarReservations(ctx context.Context, in *ListCarReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
+	ListUserReservations(ctx context.Context, in *ListUserReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
 }
 
-func (UnimplementedCarServiceServer) CreateCar(context.Context, *CreateCarRequest) (*CreateCarResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCar not implemented")
-}
-func (UnimplementedCarServiceServer) GetCar(context.Context, *GetCarRequest) (*GetCarResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCar not implemented")
-}
-func (UnimplementedCarServiceServer) DeleteCar(context.Context, *DeleteCarRequest) (*DeleteCarResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCar not implemented")
-}
-func (UnimplementedCarServiceServer) UpdateCar(context.Context, *UpdateCarRequest) (*UpdateCarResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCar not implemented")
-}
-func (UnimplementedCarServiceServer) GetAllCars(context.Context, *GetAllCarsRequest) (*GetAllCarsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCars not implemented")
+type reservationServiceClient struct {
+	cc grpc.ClientConnInterface
 }
-func (UnimplementedCarServiceServer) mustEmbedUnimplementedCarServiceServer() {}
 
-// UnsafeCarServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CarServiceServer will
-// result in compilation errors.
-type UnsafeCarServiceServer interface {
-	mustEmbedUnimplementedCarServiceServer()
+func NewReservationServiceClient(cc grpc.ClientConnInterface) ReservationServiceClient {
+	return &reservationServiceClient{cc}
 }
 
-func RegisterCarServiceServer(s grpc.ServiceRegistrar, srv CarServiceServer) {
-	s.RegisterService(&CarService_ServiceDesc, srv)
+func (c *reservationServiceClient) CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
+	out := new(CreateReservationResponse)
+	err := c.cc.Invoke(ctx, "/ReservationService/CreateReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _CarService_CreateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCarRequest)
-	if err := dec(in); err != nil {
+func (c *reservationServiceClient) CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error) {
+	out := new(CancelReservationResponse)
+	err := c.cc.Invoke(ctx, "/ReservationService/CancelReservation", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CarServiceServer).CreateCar(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/CarService/CreateCar",
+	return out, nil
+}
+
+func (c *reservationServiceClient) ListCarReservations(ctx context.Context, in *ListCarReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
+	out := new(ListReservationsResponse)
+	err := c.cc.Invoke(ctx, "/ReservationService/ListCarReservations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CarServiceServer).CreateCar(ctx, req.(*CreateCarRequest))
+	return out, nil
+}
+
+func (c *reservationServiceClient) ListUserReservations(ctx context.Context, in *ListUserReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
+	out := new(ListReservationsResponse)
+	err := c.cc.Invoke(ctx, "/ReservationService/ListUserReservations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _CarService_GetCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCarRequest)
+// ReservationServiceServer is the server API for ReservationService service.
+// All implementations must embed UnimplementedReservationServiceServer
+// for forward compatibility
+type ReservationServiceServer interface {
+	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
+	CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error)
+	ListCarReservations(context.Context, *ListCarReservationsRequest) (*ListReservationsResponse, error)
+	ListUserReservations(context.Context, *ListUserReservationsRequest) (*ListReservationsResponse, error)
+	mustEmbedUnimplementedReservationServiceServer()
+}
+
+// UnimplementedReservationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReservationServiceServer struct {
+}
+
+func (UnimplementedReservationServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
+}
+func (UnimplementedReservationServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (UnimplementedReservationServiceServer) ListCarReservations(context.Context, *ListCarReservationsRequest) (*ListReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCarReservations not implemented")
+}
+func (UnimplementedReservationServiceServer) ListUserReservations(context.Context, *ListUserReservationsRequest) (*ListReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserReservations not implemented")
+}
+func (UnimplementedReservationServiceServer) mustEmbedUnimplementedReservationServiceServer() {}
+
+// UnsafeReservationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReservationServiceServer will
+// result in compilation errors.
+type UnsafeReservationServiceServer interface {
+	mustEmbedUnimplementedReservationServiceServer()
+}
+
+func RegisterReservationServiceServer(s grpc.ServiceRegistrar, srv ReservationServiceServer) {
+	s.RegisterService(&ReservationService_ServiceDesc, srv)
+}
+
+func _ReservationService_CreateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReservationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CarServiceServer).GetCar(ctx, in)
+		return srv.(ReservationServiceServer).CreateReservation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CarService/GetCar",
+		FullMethod: "/ReservationService/CreateReservation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CarServiceServer).GetCar(ctx, req.(*GetCarRequest))
+		return srv.(ReservationServiceServer).CreateReservation(ctx, req.(*CreateReservationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CarService_DeleteCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCarRequest)
+func _ReservationService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CarServiceServer).DeleteCar(ctx, in)
+		return srv.(ReservationServiceServer).CancelReservation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CarService/DeleteCar",
+		FullMethod: "/ReservationService/CancelReservation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CarServiceServer).DeleteCar(ctx, req.(*DeleteCarRequest))
+		return srv.(ReservationServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CarService_UpdateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCarRequest)
+func _ReservationService_ListCarReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCarReservationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CarServiceServer).UpdateCar(ctx, in)
+		return srv.(ReservationServiceServer).ListCarReservations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CarService/UpdateCar",
+		FullMethod: "/ReservationService/ListCarReservations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CarServiceServer).UpdateCar(ctx, req.(*UpdateCarRequest))
+		return srv.(ReservationServiceServer).ListCarReservations(ctx, req.(*ListCarReservationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CarService_GetAllCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCarsRequest)
+func _ReservationService_ListUserReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserReservationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CarServiceServer).GetAllCars(ctx, in)
+		return srv.(ReservationServiceServer).ListUserReservations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CarService/GetAllCars",
+		FullMethod: "/ReservationService/ListUserReservations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CarServiceServer).GetAllCars(ctx, req.(*GetAllCarsRequest))
+		return srv.(ReservationServiceServer).ListUserReservations(ctx, req.(*ListUserReservationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// CarService_ServiceDesc is the grpc.ServiceDesc for CarService service.
+// ReservationService_ServiceDesc is the grpc.ServiceDesc for ReservationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var CarService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "CarService",
-	HandlerType: (*CarServiceServer)(nil),
+var ReservationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ReservationService",
+	HandlerType: (*ReservationServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateCar",
-			Handler:    _CarService_CreateCar_Handler,
-		},
-		{
-			MethodName: "GetCar",
-			Handler:    _CarService_GetCar_Handler,
+			MethodName: "CreateReservation",
+			Handler:    _ReservationService_CreateReservation_Handler,
 		},
 		{
-			MethodName: "DeleteCar",
-			Handler:    _CarService_DeleteCar_Handler,
+			MethodName: "CancelReservation",
+			Handler:    _ReservationService_CancelReservation_Handler,
 		},
 		{
-			MethodName: "UpdateCar",
-			Handler:    _CarService_UpdateCar_Handler,
+			MethodName: "ListCarReservations",
+			Handler:    _ReservationService_ListCarReservations_Handler,
 		},
 		{
-			MethodName: "GetAllCars",
-			Handler:    _CarService_GetAllCars_Handler,
+			MethodName: "ListUserReservations",
+			Handler:    _ReservationService_ListUserReservations_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services.proto",
 }
 
-// UserServiceClient is the client API for UserService service.
+// BrandServiceClient is the client API for BrandService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type UserServiceClient interface {
-	SignUpUser(ctx context.Context, in *SignUpUserRequest, opts ...grpc.CallOption) (*SignUpUserResponse, error)
-	SignUpAdmin(ctx context.Context, in *SignUpAdminRequest, opts ...grpc.CallOption) (*SignUpAdminResponse, error)
-	GetByLogin(ctx context.Context, in *GetByLoginRequest, opts ...grpc.CallOption) (*GetByLoginResponse, error)
-	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+type BrandServiceClient interface {
+	CreateBrand(ctx context.Context, in *CreateBrandRequest, opts ...grpc.CallOption) (*CreateBrandResponse, error)
+	GetBrand(ctx context.Context, in *GetBrandRequest, opts ...grpc.CallOption) (*GetBrandResponse, error)
+	ListBrands(ctx context.Context, in *ListBrandsRequest, opts ...grpc.CallOption) (*ListBrandsResponse, error)
+	UpdateBrand(ctx context.Context, in *UpdateBrandRequest, opts ...grpc.CallOption) (*UpdateBrandResponse, error)
+	DeleteBrand(ctx context.Context, in *DeleteBrandRequest, opts ...grpc.CallOption) (*DeleteBrandResponse, error)
 }
 
-type userServiceClient struct {
+type brandServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
-	return &userServiceClient{cc}
+func NewBrandServiceClient(cc grpc.ClientConnInterface) BrandServiceClient {
+	return &brandServiceClient{cc}
 }
 
-func (c *userServiceClient) SignUpUser(ctx context.Context, in *SignUpUserRequest, opts ...grpc.CallOption) (*SignUpUserResponse, error) {
-	out := new(SignUpUserResponse)
-	err := c.cc.Invoke(ctx, "/UserService/SignUpUser", in, out, opts...)
+func (c *brandServiceClient) CreateBrand(ctx context.Context, in *CreateBrandRequest, opts ...grpc.CallOption) (*CreateBrandResponse, error) {
+	out := new(CreateBrandResponse)
+	err := c.cc.Invoke(ctx, "/BrandService/CreateBrand", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) SignUpAdmin(ctx context.Context, in *SignUpAdminRequest, opts ...grpc.CallOption) (*SignUpAdminResponse, error) {
-	out := new(SignUpAdminResponse)
-	err := c.cc.Invoke(ctx, "/UserService/SignUpAdmin", in, out, opts...)
+func (c *brandServiceClient) GetBrand(ctx context.Context, in *GetBrandRequest, opts ...grpc.CallOption) (*GetBrandResponse, error) {
+	out := new(GetBrandResponse)
+	err := c.cc.Invoke(ctx, "/BrandService/GetBrand", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) GetByLogin(ctx context.Context, in *GetByLoginRequest, opts ...grpc.CallOption) (*GetByLoginResponse, error) {
-	out := new(GetByLoginResponse)
-	err := c.cc.Invoke(ctx, "/UserService/GetByLogin", in, out, opts...)
+func (c *brandServiceClient) ListBrands(ctx context.Context, in *ListBrandsRequest, opts ...grpc.CallOption) (*ListBrandsResponse, error) {
+	out := new(ListBrandsResponse)
+	err := c.cc.Invoke(ctx, "/BrandService/ListBrands", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
-	out := new(RefreshTokenResponse)
-	err := c.cc.Invoke(ctx, "/UserService/RefreshToken", in, out, opts...)
+func (c *brandServiceClient) UpdateBrand(ctx context.Context, in *UpdateBrandRequest, opts ...grpc.CallOption) (*UpdateBrandResponse, error) {
+	out := new(UpdateBrandResponse)
+	err := c.cc.Invoke(ctx, "/BrandService/UpdateBrand", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// UserServiceServer is the server API for UserService service.
-// All implementations must embed UnimplementedUserServiceServer
+func (c *brandServiceClient) DeleteBrand(ctx context.Context, in *DeleteBrandRequest, opts ...grpc.CallOption) (*DeleteBrandResponse, error) {
+	out := new(DeleteBrandResponse)
+	err := c.cc.Invoke(ctx, "/BrandService/DeleteBrand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BrandServiceServer is the server API for BrandService service.
+// All implementations must embed UnimplementedBrandServiceServer
 // for forward compatibility
-type UserServiceServer interface {
-	SignUpUser(context.Context, *SignUpUserRequest) (*SignUpUserResponse, error)
-	SignUpAdmin(context.Context, *SignUpAdminRequest) (*SignUpAdminResponse, error)
-	GetByLogin(context.Context, *GetByLoginRequest) (*GetByLoginResponse, error)
-	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
-	mustEmbedUnimplementedUserServiceServer()
+type BrandServiceServer interface {
+	CreateBrand(context.Context, *CreateBrandRequest) (*CreateBrandResponse, error)
+	GetBrand(context.Context, *GetBrandRequest) (*GetBrandResponse, error)
+	ListBrands(context.Context, *ListBrandsRequest) (*ListBrandsResponse, error)
+	UpdateBrand(context.Context, *UpdateBrandRequest) (*UpdateBrandResponse, error)
+	DeleteBrand(context.Context, *DeleteBrandRequest) (*DeleteBrandResponse, error)
+	mustEmbedUnimplementedBrandServiceServer()
 }
 
-// UnimplementedUserServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedUserServiceServer struct {
+// UnimplementedBrandServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBrandServiceServer struct {
 }
 
-func (UnimplementedUserServiceServer) SignUpUser(context.Context, *SignUpUserRequest) (*SignUpUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignUpUser not implemented")
+func (UnimplementedBrandServiceServer) CreateBrand(context.Context, *CreateBrandRequest) (*CreateBrandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBrand not implemented")
 }
-func (UnimplementedUserServiceServer) SignUpAdmin(context.Context, *SignUpAdminRequest) (*SignUpAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignUpAdmin not implemented")
+func (UnimplementedBrandServiceServer) GetBrand(context.Context, *GetBrandRequest) (*GetBrandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBrand not implemented")
 }
-func (UnimplementedUserServiceServer) GetByLogin(context.Context, *GetByLoginRequest) (*GetByLoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetByLogin not implemented")
+func (UnimplementedBrandServiceServer) ListBrands(context.Context, *ListBrandsRequest) (*ListBrandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrands not implemented")
 }
-func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+func (UnimplementedBrandServiceServer) UpdateBrand(context.Context, *UpdateBrandRequest) (*UpdateBrandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrand not implemented")
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedBrandServiceServer) DeleteBrand(context.Context, *DeleteBrandRequest) (*DeleteBrandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBrand not implemented")
+}
+func (UnimplementedBrandServiceServer) mustEmbedUnimplementedBrandServiceServer() {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
+// UnsafeBrandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BrandServiceServer will
 // result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+type UnsafeBrandServiceServer interface {
+	mustEmbedUnimplementedBrandServiceServer()
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	s.RegisterService(&UserService_ServiceDesc, srv)
+func RegisterBrandServiceServer(s grpc.ServiceRegistrar, srv BrandServiceServer) {
+	s.RegisterService(&BrandService_ServiceDesc, srv)
 }
 
-func _UserService_SignUpUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignUpUserRequest)
+func _BrandService_CreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBrandRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).SignUpUser(ctx, in)
+		return srv.(BrandServiceServer).CreateBrand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/UserService/SignUpUser",
+		FullMethod: "/BrandService/CreateBrand",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SignUpUser(ctx, req.(*SignUpUserRequest))
+		return srv.(BrandServiceServer).CreateBrand(ctx, req.(*CreateBrandRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_SignUpAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignUpAdminRequest)
+func _BrandService_GetBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBrandRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).SignUpAdmin(ctx, in)
+		return srv.(BrandServiceServer).GetBrand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/UserService/SignUpAdmin",
+		FullMethod: "/BrandService/GetBrand",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SignUpAdmin(ctx, req.(*SignUpAdminRequest))
+		return srv.(BrandServiceServer).GetBrand(ctx, req.(*GetBrandRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetByLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByLoginRequest)
+func _BrandService_ListBrands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrandsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetByLogin(ctx, in)
+		return srv.(BrandServiceServer).ListBrands(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/UserService/GetByLogin",
+		FullMethod: "/BrandService/ListBrands",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetByLogin(ctx, req.(*GetByLoginRequest))
+		return srv.(BrandServiceServer).ListBrands(ctx, req.(*ListBrandsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _BrandService_UpdateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBrandRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RefreshToken(ctx, in)
+		return srv.(BrandServiceServer).UpdateBrand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/UserService/RefreshToken",
+		FullMethod: "/BrandService/UpdateBrand",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(BrandServiceServer).UpdateBrand(ctx, req.(*UpdateBrandRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+func _BrandService_DeleteBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrandServiceServer).DeleteBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BrandService/DeleteBrand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrandServiceServer).DeleteBrand(ctx, req.(*DeleteBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BrandService_ServiceDesc is the grpc.ServiceDesc for BrandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "UserService",
-	HandlerType: (*UserServiceServer)(nil),
+var BrandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "BrandService",
+	HandlerType: (*BrandServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "SignUpUser",
-			Handler:    _UserService_SignUpUser_Handler,
+			MethodName: "CreateBrand",
+			Handler:    _BrandService_CreateBrand_Handler,
 		},
 		{
-			MethodName: "SignUpAdmin",
-			Handler:    _UserService_SignUpAdmin_Handler,
+			MethodName: "GetBrand",
+			Handler:    _BrandService_GetBrand_Handler,
 		},
 		{
-			MethodName: "GetByLogin",
-			Handler:    _UserService_GetByLogin_Handler,
+			MethodName: "ListBrands",
+			Handler:    _BrandService_ListBrands_Handler,
 		},
 		{
-			MethodName: "RefreshToken",
-			Handler:    _UserService_RefreshToken_Handler,
+			MethodName: "UpdateBrand",
+			Handler:    _BrandService_UpdateBrand_Handler,
+		},
+		{
+			MethodName: "DeleteBrand",
+			Handler:    _BrandService_DeleteBrand_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -448,6 +1614,8 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 type ImageServiceClient interface {
 	DownloadImage(ctx context.Context, in *DownloadImageRequest, opts ...grpc.CallOption) (ImageService_DownloadImageClient, error)
 	UploadImage(ctx context.Context, opts ...grpc.CallOption) (ImageService_UploadImageClient, error)
+	ListCarImages(ctx context.Context, in *ListCarImagesRequest, opts ...grpc.CallOption) (*ListCarImagesResponse, error)
+	SetPrimaryCarImage(ctx context.Context, in *SetPrimaryCarImageRequest, opts ...grpc.CallOption) (*SetPrimaryCarImageResponse, error)
 }
 
 type imageServiceClient struct {
@@ -524,12 +1692,32 @@ func (x *imageServiceUploadImageClient) CloseAndRecv() (*UploadImageResponse, er
 	return m, nil
 }
 
+func (c *imageServiceClient) ListCarImages(ctx context.Context, in *ListCarImagesRequest, opts ...grpc.CallOption) (*ListCarImagesResponse, error) {
+	out := new(ListCarImagesResponse)
+	err := c.cc.Invoke(ctx, "/ImageService/ListCarImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) SetPrimaryCarImage(ctx context.Context, in *SetPrimaryCarImageRequest, opts ...grpc.CallOption) (*SetPrimaryCarImageResponse, error) {
+	out := new(SetPrimaryCarImageResponse)
+	err := c.cc.Invoke(ctx, "/ImageService/SetPrimaryCarImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ImageServiceServer is the server API for ImageService service.
 // All implementations must embed UnimplementedImageServiceServer
 // for forward compatibility
 type ImageServiceServer interface {
 	DownloadImage(*DownloadImageRequest, ImageService_DownloadImageServer) error
 	UploadImage(ImageService_UploadImageServer) error
+	ListCarImages(context.Context, *ListCarImagesRequest) (*ListCarImagesResponse, error)
+	SetPrimaryCarImage(context.Context, *SetPrimaryCarImageRequest) (*SetPrimaryCarImageResponse, error)
 	mustEmbedUnimplementedImageServiceServer()
 }
 
@@ -543,6 +1731,12 @@ func (UnimplementedImageServiceServer) DownloadImage(*DownloadImageRequest, Imag
 func (UnimplementedImageServiceServer) UploadImage(ImageService_UploadImageServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
 }
+func (UnimplementedImageServiceServer) ListCarImages(context.Context, *ListCarImagesRequest) (*ListCarImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCarImages not implemented")
+}
+func (UnimplementedImageServiceServer) SetPrimaryCarImage(context.Context, *SetPrimaryCarImageRequest) (*SetPrimaryCarImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryCarImage not implemented")
+}
 func (UnimplementedImageServiceServer) mustEmbedUnimplementedImageServiceServer() {}
 
 // UnsafeImageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -603,13 +1797,58 @@ func (x *imageServiceUploadImageServer) Recv() (*UploadImageRequest, error) {
 	return m, nil
 }
 
+func _ImageService_ListCarImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCarImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ListCarImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ImageService/ListCarImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ListCarImages(ctx, req.(*ListCarImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_SetPrimaryCarImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryCarImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).SetPrimaryCarImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ImageService/SetPrimaryCarImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).SetPrimaryCarImage(ctx, req.(*SetPrimaryCarImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ImageService_ServiceDesc is the grpc.ServiceDesc for ImageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var ImageService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ImageService",
 	HandlerType: (*ImageServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCarImages",
+			Handler:    _ImageService_ListCarImages_Handler,
+		},
+		{
+			MethodName: "SetPrimaryCarImage",
+			Handler:    _ImageService_SetPrimaryCarImage_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "DownloadImage",